@@ -16,6 +16,8 @@
 package gosec
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -82,6 +84,20 @@ type Analyzer struct {
 	stats       *Metrics
 	errors      map[string][]Error // keys are file paths; values are the golang errors in those files
 	tests       bool
+	maxIssues   int
+	// generatedRules holds the rules enabled on the current file when it is
+	// generated, and is nil for the regular files
+	generatedRules map[string]bool
+	// rulePaths holds the path patterns of the loaded rules, computed once by LoadRules
+	rulePaths map[string]pathPatterns
+	// skippedRules holds the rules whose configured paths exclude the current file
+	skippedRules map[string]bool
+	// issueHandler is called with each issue as soon as it is found
+	issueHandler func(*Issue)
+	// discardIssues stops the issues from being kept once they were handled
+	discardIssues bool
+	// issueFilter drops the issues for which it returns false
+	issueFilter func(*Issue) bool
 }
 
 // NewAnalyzer builds a new analyzer.
@@ -96,6 +112,7 @@ func NewAnalyzer(conf Config, tests bool, logger *log.Logger) *Analyzer {
 	return &Analyzer{
 		ignoreNosec: ignoreNoSec,
 		ruleset:     make(RuleSet),
+		rulePaths:   make(map[string]pathPatterns),
 		context:     &Context{},
 		config:      conf,
 		logger:      logger,
@@ -116,6 +133,44 @@ func (gosec *Analyzer) Config() Config {
 	return gosec.config
 }
 
+// Rules returns the rules loaded into the analyzer
+func (gosec *Analyzer) Rules() RuleSet {
+	return gosec.ruleset
+}
+
+// SetMaxIssues stops the analysis as soon as n issues were found. The metrics
+// then only account for the files which were scanned. A value lower than 1
+// disables the limit.
+func (gosec *Analyzer) SetMaxIssues(n int) {
+	gosec.maxIssues = n
+}
+
+// SetIssueHandler registers a function called with each issue as soon as it is
+// found, which allows streaming the issues while the scan is still running.
+func (gosec *Analyzer) SetIssueHandler(handler func(*Issue)) {
+	gosec.issueHandler = handler
+}
+
+// SetDiscardIssues stops the analyzer from keeping the issues it finds, which
+// are then only passed to the issue handler. Report returns no issues, and the
+// metrics still count them. This keeps the memory flat when the issues are only
+// streamed.
+func (gosec *Analyzer) SetDiscardIssues(discard bool) {
+	gosec.discardIssues = discard
+}
+
+// SetIssueFilter registers a function selecting the issues to report, e.g. by
+// severity. The other issues are dropped as soon as they are found, and count
+// neither in the metrics nor towards the maximum number of issues.
+func (gosec *Analyzer) SetIssueFilter(filter func(*Issue) bool) {
+	gosec.issueFilter = filter
+}
+
+// limitReached returns true when the maximum number of issues was found
+func (gosec *Analyzer) limitReached() bool {
+	return gosec.maxIssues > 0 && gosec.stats.NumFound >= gosec.maxIssues
+}
+
 // LoadRules instantiates all the rules to be used when analyzing source
 // packages
 func (gosec *Analyzer) LoadRules(ruleDefinitions map[string]RuleBuilder) {
@@ -129,49 +184,79 @@ func (gosec *Analyzer) LoadRules(ruleDefinitions map[string]RuleBuilder) {
 		def := ruleDefinitions[id]
 		r, nodes := def(id, gosec.config)
 		gosec.ruleset.Register(r, nodes...)
+		if paths, ok := rulePaths(r.ID(), gosec.config); ok {
+			gosec.rulePaths[r.ID()] = paths
+		}
 	}
 }
 
 // Process kicks off the analysis process for a given package
 func (gosec *Analyzer) Process(buildTags []string, packagePaths ...string) error {
+	return gosec.ProcessWithContext(context.Background(), buildTags, packagePaths...)
+}
+
+// ProcessWithContext runs the analysis like Process but stops between two packages
+// once the context is done. The results of the packages scanned so far are kept
+// and can still be reported.
+func (gosec *Analyzer) ProcessWithContext(ctx context.Context, buildTags []string, packagePaths ...string) error {
 	config := &packages.Config{
+		Context:    ctx,
 		Mode:       LoadMode,
 		BuildFlags: buildTags,
 		Tests:      gosec.tests,
 	}
 
+	defer sortErrors(gosec.errors)
 	for _, pkgPath := range packagePaths {
+		if gosec.limitReached() {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return interruptedError(pkgPath, err)
+		}
 		pkgs, err := gosec.load(pkgPath, config)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return interruptedError(pkgPath, ctxErr)
+		}
 		if err != nil {
 			gosec.AppendError(pkgPath, err)
 		}
 		for _, pkg := range pkgs {
+			if gosec.limitReached() {
+				break
+			}
+			if err := ctx.Err(); err != nil {
+				return interruptedError(pkgPath, err)
+			}
 			if pkg.Name != "" {
-				err := gosec.ParseErrors(pkg)
-				if err != nil {
-					return fmt.Errorf("parsing errors in pkg %q: %v", pkg.Name, err)
+				// A package which fails to load is reported along with the other
+				// errors but should not prevent the remaining packages from being scanned.
+				if err := gosec.ParseErrors(pkg); err != nil {
+					gosec.AppendError(pkgPath, fmt.Errorf("parsing errors in pkg %q: %v", pkg.Name, err))
+					continue
 				}
 				gosec.Check(pkg)
 			}
 		}
 	}
-	sortErrors(gosec.errors)
 	return nil
 }
 
+func interruptedError(pkgPath string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("scan timed out before package %q: %w", pkgPath, err)
+	}
+	return fmt.Errorf("scan cancelled before package %q: %w", pkgPath, err)
+}
+
 const sep = os.PathSeparator
 
 var reTestsPath = regexp.MustCompile(fmt.Sprintf("(^\\s*tests%c?)|%c\\s*tests\\s*%c|%c\\s*tests\\s*$", sep, sep, sep, sep))
 
-func allowedFiles(fullPaths ...string) (filtered []string) {
-	for _, fullPath := range fullPaths {
-		// Skip over "/tests/" files as they are generating lots of noise.
-		// Please see https://github.com/cosmos/gosec/issues/60
-		if !reTestsPath.MatchString(fullPath) {
-			filtered = append(filtered, fullPath)
-		}
-	}
-	return filterOutGeneratedGoFiles(filtered)
+// isGeneratedFile returns true for the protobuf files and the files carrying
+// the standard header of generated Go code.
+func isGeneratedFile(fullPath string) bool {
+	return strings.HasSuffix(fullPath, ".pb.go") || len(filterOutGeneratedGoFiles([]string{fullPath})) == 0
 }
 
 var reGeneratedGoFile = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.`)
@@ -331,6 +416,10 @@ func (gosec *Analyzer) Check(pkg *packages.Package) {
 	gosec.logger.Println("Checking package:", pkg.Name)
 
 	for _, file := range pkg.Syntax {
+		if gosec.limitReached() {
+			gosec.logger.Println("Maximum number of issues reached, stopping the scan")
+			break
+		}
 		checkedFile := pkg.Fset.File(file.Pos()).Name()
 		// Skip the no-Go file from analysis (e.g. a Cgo files is expanded in 3 different files
 		// stored in the cache which do not need to by analyzed)
@@ -356,10 +445,25 @@ func (gosec *Analyzer) Check(pkg *packages.Package) {
 		gosec.context.Imports.TrackFile(file)
 		gosec.context.PassedValues = make(map[string]interface{})
 
-		// Only walk non-generated Go files as we definitely don't
-		// want to report on generated code, which is out of our direct control.
+		// Skip over "/tests/" files as they are generating lots of noise.
+		// Please see https://github.com/cosmos/gosec/issues/60
+		//
+		// Generated Go files are only walked for the rules which were explicitly
+		// enabled on them as we otherwise don't want to report on generated code,
+		// which is out of our direct control.
 		// Please see: https://github.com/cosmos/gosec/issues/30
-		if filtered := allowedFiles(checkedFile); len(filtered) > 0 {
+		gosec.skippedRules = make(map[string]bool)
+		for id, paths := range gosec.rulePaths {
+			if !paths.applies(checkedFile) {
+				gosec.skippedRules[id] = true
+			}
+		}
+
+		gosec.generatedRules = nil
+		if isGeneratedFile(checkedFile) {
+			gosec.generatedRules = gosec.config.GeneratedCodeRules()
+		}
+		if !reTestsPath.MatchString(checkedFile) && (gosec.generatedRules == nil || len(gosec.generatedRules) > 0) {
 			ast.Walk(gosec, file)
 		}
 		gosec.stats.NumFiles++
@@ -467,6 +571,12 @@ func (gosec *Analyzer) Visit(n ast.Node) ast.Visitor {
 		return gosec
 	}
 
+	// Stop walking once enough issues were found. Nothing is pushed onto the
+	// ignores stack so it stays balanced while the walk unwinds.
+	if gosec.limitReached() {
+		return nil
+	}
+
 	// Get any new rule exclusions.
 	ignoredRules, ignoreAll := gosec.ignore(n)
 	if ignoreAll {
@@ -492,7 +602,10 @@ func (gosec *Analyzer) Visit(n ast.Node) ast.Visitor {
 	gosec.context.Imports.TrackImport(n)
 
 	for _, rule := range gosec.ruleset.RegisteredFor(n) {
-		if _, ok := ignores[rule.ID()]; ok {
+		if _, ok := ignores[rule.ID()]; ok || gosec.skippedRules[rule.ID()] {
+			continue
+		}
+		if gosec.generatedRules != nil && !gosec.generatedRules[rule.ID()] {
 			continue
 		}
 		issue, err := rule.Match(n, gosec.context)
@@ -501,9 +614,17 @@ func (gosec *Analyzer) Visit(n ast.Node) ast.Visitor {
 			file = path.Base(file)
 			gosec.logger.Printf("Rule error: %T => %s (%s:%d)\n", rule, err, file, line)
 		}
-		if issue != nil {
-			gosec.issues = append(gosec.issues, issue)
+		if issue != nil && (gosec.issueFilter == nil || gosec.issueFilter(issue)) {
+			if !gosec.discardIssues || gosec.issueHandler == nil {
+				gosec.issues = append(gosec.issues, issue)
+			}
+			if gosec.issueHandler != nil {
+				gosec.issueHandler(issue)
+			}
 			gosec.stats.NumFound++
+			if gosec.limitReached() {
+				break
+			}
 		}
 	}
 	return gosec
@@ -520,4 +641,5 @@ func (gosec *Analyzer) Reset() {
 	gosec.issues = make([]*Issue, 0, 16)
 	gosec.stats = &Metrics{}
 	gosec.ruleset = NewRuleSet()
+	gosec.rulePaths = make(map[string]pathPatterns)
 }
@@ -1,7 +1,9 @@
 package gosec_test
 
 import (
+	"context"
 	"errors"
+	"go/ast"
 	"io/ioutil"
 	"log"
 	"os"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/cosmos/gosec/v2"
 	"github.com/cosmos/gosec/v2/rules"
+	"github.com/cosmos/gosec/v2/rules/sdk"
 	"golang.org/x/tools/go/packages"
 
 	"github.com/cosmos/gosec/v2/testutils"
@@ -143,6 +146,116 @@ var _ = Describe("Analyzer", func() {
 			}
 		})
 
+		It("should keep scanning the valid packages when one fails to load", func() {
+			sample := testutils.SampleCodeG401[0]
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+
+			brokenPackage := testutils.NewTestPackage()
+			defer brokenPackage.Close()
+			// the colon in the file name makes the position of the error unparsable
+			brokenPackage.AddFile("foo:bar.go", `
+				package main
+				func main()
+				}`)
+			err := brokenPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			validPackage := testutils.NewTestPackage()
+			defer validPackage.Close()
+			validPackage.AddFile("md5.go", sample.Code[0])
+			err = validPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = analyzer.Process(buildTags, brokenPackage.Path, validPackage.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, errors := analyzer.Report()
+			Expect(issues).Should(HaveLen(sample.Errors))
+			Expect(errors).Should(HaveKey(HavePrefix(brokenPackage.Path)))
+			Expect(errors).ShouldNot(HaveKey(HavePrefix(validPackage.Path)))
+		})
+
+		It("should keep the partial results when the scan is cancelled", func() {
+			sample := testutils.SampleCodeG401[0]
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			analyzer.LoadRules(map[string]gosec.RuleBuilder{
+				"G401": func(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+					rule, nodes := rules.NewUsesWeakCryptography(id, conf)
+					return &cancellingRule{Rule: rule, cancel: cancel}, nodes
+				},
+			})
+
+			firstPackage := testutils.NewTestPackage()
+			defer firstPackage.Close()
+			firstPackage.AddFile("md5.go", sample.Code[0])
+			err := firstPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			secondPackage := testutils.NewTestPackage()
+			defer secondPackage.Close()
+			secondPackage.AddFile("md5.go", sample.Code[0])
+			err = secondPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = analyzer.ProcessWithContext(ctx, buildTags, firstPackage.Path, secondPackage.Path)
+			Expect(errors.Is(err, context.Canceled)).Should(BeTrue())
+			Expect(err.Error()).Should(ContainSubstring(secondPackage.Path))
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(HaveLen(sample.Errors))
+			Expect(metrics.NumFiles).Should(Equal(1))
+		})
+
+		It("should expose the rules registered for a node type", func() {
+			analyzer.LoadRules(map[string]gosec.RuleBuilder{
+				"G705": sdk.NewMapRangingCheck,
+				"G401": rules.NewUsesWeakCryptography,
+			})
+			Expect(analyzer.Rules().RegisteredIDsFor((*ast.RangeStmt)(nil))).Should(ContainElement("G705"))
+			Expect(analyzer.Rules().RegisteredIDsFor((*ast.RangeStmt)(nil))).ShouldNot(ContainElement("G401"))
+			Expect(analyzer.Rules().AllRules()).Should(HaveLen(2))
+		})
+
+		It("should only run the rules enabled on generated code", func() {
+			sample := testutils.SampleCodeG401[0]
+			source := "// Code generated by protoc-gen-gogo. DO NOT EDIT.\n" + sample.Code[0]
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+
+			generatedPackage := testutils.NewTestPackage()
+			defer generatedPackage.Close()
+			generatedPackage.AddFile("md5.go", source)
+			err := generatedPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, generatedPackage.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := analyzer.Report()
+			Expect(issues).Should(BeEmpty())
+
+			config := gosec.NewConfig()
+			config.SetGlobal(gosec.Generated, "G401")
+			optedIn := gosec.NewAnalyzer(config, tests, logger)
+			optedIn.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+			err = optedIn.Process(buildTags, generatedPackage.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ = optedIn.Report()
+			Expect(issues).Should(HaveLen(sample.Errors))
+		})
+
+		It("should treat protobuf files as generated code", func() {
+			sample := testutils.SampleCodeG401[0]
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+
+			pbPackage := testutils.NewTestPackage()
+			defer pbPackage.Close()
+			pbPackage.AddFile("md5.pb.go", sample.Code[0])
+			err := pbPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pbPackage.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(BeEmpty())
+			Expect(metrics.NumFiles).Should(Equal(1))
+		})
+
 		It("should not report errors when a nosec comment is present", func() {
 			sample := testutils.SampleCodeG401[0]
 			source := sample.Code[0]
@@ -338,6 +451,158 @@ var _ = Describe("Analyzer", func() {
 			issues, _, _ := customAnalyzer.Report()
 			Expect(issues).Should(HaveLen(1))
 		})
+
+		It("should only report the code both on the included paths and in the scope of a rule", func() {
+			conf := gosec.NewConfig()
+			conf.Set("G706", map[string]interface{}{
+				"include": []interface{}{"*.go"},
+				"exclude": []interface{}{"endblock.go"},
+			})
+			customAnalyzer := gosec.NewAnalyzer(conf, tests, logger)
+			customAnalyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G706")).Builders())
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("endblock.go", `
+				package keeper
+				import "time"
+				type Keeper struct{}
+				func (k Keeper) EndBlocker() {
+					time.Sleep(time.Second)
+				}`)
+			pkg.AddFile("beginblock.go", `
+				package keeper
+				import "time"
+				func (k Keeper) BeginBlocker() {
+					time.Sleep(time.Second)
+				}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).Should(HaveLen(1))
+			Expect(issues[0].File).Should(HaveSuffix("beginblock.go"))
+
+			conf.Set("G706", map[string]interface{}{
+				"include": []interface{}{"*.go"},
+				"scope":   "^main$",
+			})
+			customAnalyzer = gosec.NewAnalyzer(conf, tests, logger)
+			customAnalyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G706")).Builders())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ = customAnalyzer.Report()
+			Expect(issues).Should(BeEmpty())
+		})
+
+		It("should pass each issue to the issue handler as soon as it is found", func() {
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+			var handled []*gosec.Issue
+			analyzer.SetIssueHandler(func(issue *gosec.Issue) {
+				handled = append(handled, issue)
+			})
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", `
+				package main
+				import "crypto/md5"
+				func main() {
+					_ = md5.New()
+					_ = md5.New()
+				}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := analyzer.Report()
+			Expect(handled).Should(HaveLen(2))
+			Expect(handled).Should(Equal(issues))
+		})
+
+		It("should only count the issues passed to the handler when they are discarded", func() {
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+			var handled []*gosec.Issue
+			analyzer.SetIssueHandler(func(issue *gosec.Issue) {
+				handled = append(handled, issue)
+			})
+			analyzer.SetDiscardIssues(true)
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", `
+				package main
+				import "crypto/md5"
+				func main() {
+					_ = md5.New()
+					_ = md5.New()
+				}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(handled).Should(HaveLen(2))
+			Expect(issues).Should(BeEmpty())
+			Expect(metrics.NumFound).Should(Equal(2))
+		})
+
+		It("should stop the scan once the maximum number of issues is reached", func() {
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G401")).Builders())
+			analyzer.SetMaxIssues(2)
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("a.go", `
+				package main
+				import "crypto/md5"
+				func main() {
+					_ = md5.New()
+					_ = md5.New()
+					_ = md5.New()
+				}`)
+			pkg.AddFile("b.go", `
+				package main
+				import "crypto/md5"
+				func other() {
+					_ = md5.New()
+				}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(HaveLen(2))
+			Expect(metrics.NumFound).Should(Equal(2))
+			Expect(metrics.NumFiles).Should(Equal(1))
+		})
+
+		It("should only count the filtered issues towards the maximum number of issues", func() {
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G101", "G401")).Builders())
+			analyzer.SetIssueFilter(func(issue *gosec.Issue) bool {
+				return issue.Severity >= gosec.High
+			})
+			analyzer.SetMaxIssues(1)
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("main.go", `
+				package main
+				import (
+					"crypto/md5"
+					"fmt"
+				)
+				func main() {
+					_ = md5.New()
+					_ = md5.New()
+					password := "f62e5bcda4fae4f82370da0c6f20697b8f8447ef"
+					fmt.Println(password)
+				}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(HaveLen(1))
+			Expect(issues[0].RuleID).Should(Equal("G101"))
+			Expect(metrics.NumFound).Should(Equal(1))
+		})
 	})
 	It("should be able to analyze Cgo files", func() {
 		analyzer.LoadRules(rules.Generate().Builders())
@@ -524,3 +789,17 @@ var _ = Describe("Analyzer", func() {
 		})
 	})
 })
+
+// cancellingRule cancels the scan once the wrapped rule reports an issue
+type cancellingRule struct {
+	gosec.Rule
+	cancel context.CancelFunc
+}
+
+func (r *cancellingRule) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	issue, err := r.Rule.Match(n, ctx)
+	if issue != nil {
+		r.cancel()
+	}
+	return issue, err
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/cosmos/gosec/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exit code", func() {
+	var issues []*gosec.Issue
+	var goErrors map[string][]gosec.Error
+
+	BeforeEach(func() {
+		issues = []*gosec.Issue{{RuleID: "G101"}}
+		goErrors = map[string][]gosec.Error{
+			"main.go": {{Line: 1, Column: 1, Err: "expected 'package', found 'EOF'"}},
+		}
+	})
+
+	It("succeeds when nothing was found", func() {
+		Expect(exitCode(0, nil, nil, false, true)).To(Equal(0))
+	})
+
+	It("fails when issues were found or the scan was interrupted", func() {
+		Expect(exitCode(len(issues), nil, nil, false, false)).To(Equal(exitIssues))
+		Expect(exitCode(0, nil, errors.New("timeout"), false, false)).To(Equal(exitIssues))
+		Expect(exitCode(len(issues), nil, nil, true, false)).To(Equal(0))
+	})
+
+	It("fails on Go errors like on issues without -fail-on-errors", func() {
+		Expect(exitCode(0, goErrors, nil, false, false)).To(Equal(exitIssues))
+		Expect(exitCode(len(issues), goErrors, nil, false, false)).To(Equal(exitIssues))
+		Expect(exitCode(0, goErrors, nil, true, false)).To(Equal(0))
+	})
+
+	It("fails with a distinct code on Go errors with -fail-on-errors", func() {
+		Expect(exitCode(0, goErrors, nil, false, true)).To(Equal(exitErrors))
+		Expect(exitCode(len(issues), goErrors, nil, false, true)).To(Equal(exitErrors))
+		Expect(exitCode(0, goErrors, nil, true, true)).To(Equal(exitErrors))
+	})
+})
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/cosmos/gosec/v2"
+	"github.com/cosmos/gosec/v2/rules"
+)
+
+// ruleInfo describes a rule as it is instantiated with the current configuration
+type ruleInfo struct {
+	ID          string      `json:"id"`
+	Description string      `json:"description"`
+	What        string      `json:"what"`
+	Severity    gosec.Score `json:"severity"`
+	Confidence  gosec.Score `json:"confidence"`
+	CWE         string      `json:"cwe,omitempty"`
+}
+
+// collectRules builds every rule of the list in order to report its metadata
+func collectRules(ruleDefinitions rules.RuleList, config gosec.Config) []ruleInfo {
+	infos := make([]ruleInfo, 0, len(ruleDefinitions))
+	for id, def := range ruleDefinitions {
+		info := ruleInfo{ID: id, Description: def.Description}
+		rule, _ := def.Create(id, config)
+		if meta, ok := ruleMetaData(rule); ok {
+			info.What = meta.What
+			info.Severity = meta.Severity
+			info.Confidence = meta.Confidence
+		}
+		// Some rules build their message when an issue is found
+		if info.What == "" {
+			info.What = def.Description
+		}
+		if cwe, ok := gosec.IssueToCWE[id]; ok {
+			info.CWE = cwe.ID
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// ruleMetaData extracts the gosec.MetaData embedded in the rule implementation
+func ruleMetaData(rule gosec.Rule) (gosec.MetaData, bool) {
+	v := reflect.Indirect(reflect.ValueOf(rule))
+	if v.Kind() != reflect.Struct {
+		return gosec.MetaData{}, false
+	}
+	field := v.FieldByName("MetaData")
+	if !field.IsValid() {
+		return gosec.MetaData{}, false
+	}
+	meta, ok := field.Interface().(gosec.MetaData)
+	return meta, ok
+}
+
+// listRules writes the metadata of the rules either as a text table or as JSON
+func listRules(w io.Writer, format string, ruleDefinitions rules.RuleList, config gosec.Config) error {
+	infos := collectRules(ruleDefinitions, config)
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(infos)
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tSEVERITY\tCONFIDENCE\tCWE\tDESCRIPTION")
+		for _, info := range infos {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", info.ID, info.Severity, info.Confidence, info.CWE, info.What)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("invalid format %q for the rule list. Valid options: text, json", format)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cosmos/gosec/v2"
+	"github.com/cosmos/gosec/v2/rules"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Listing the rules", func() {
+	var ruleDefinitions rules.RuleList
+
+	BeforeEach(func() {
+		ruleDefinitions = rules.Generate()
+	})
+
+	It("reports the metadata of every rule", func() {
+		infos := collectRules(ruleDefinitions, gosec.NewConfig())
+		Expect(infos).To(HaveLen(len(ruleDefinitions)))
+
+		byID := make(map[string]ruleInfo)
+		for _, info := range infos {
+			Expect(info.What).NotTo(BeEmpty(), info.ID)
+			Expect(info.Description).NotTo(BeEmpty(), info.ID)
+			byID[info.ID] = info
+		}
+		for _, id := range []string{"G101", "G401", "G701", "G704"} {
+			Expect(byID).To(HaveKey(id))
+		}
+		Expect(byID["G401"].CWE).To(Equal("326"))
+		Expect(byID["G701"].Severity).To(Equal(gosec.High))
+	})
+
+	It("prints the rules as JSON", func() {
+		buf := new(bytes.Buffer)
+		Expect(listRules(buf, "json", ruleDefinitions, gosec.NewConfig())).To(Succeed())
+
+		var infos []map[string]interface{}
+		Expect(json.Unmarshal(buf.Bytes(), &infos)).To(Succeed())
+		Expect(infos).To(HaveLen(len(ruleDefinitions)))
+		Expect(infos[0]).To(HaveKeyWithValue("id", "G101"))
+		Expect(infos[0]).To(HaveKeyWithValue("severity", "HIGH"))
+	})
+
+	It("prints the rules as a table", func() {
+		buf := new(bytes.Buffer)
+		Expect(listRules(buf, "text", ruleDefinitions, gosec.NewConfig())).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("G701"))
+		Expect(buf.String()).To(ContainSubstring("Potential integer overflow by integer type conversion"))
+	})
+
+	It("rejects unsupported formats", func() {
+		Expect(listRules(new(bytes.Buffer), "csv", ruleDefinitions, gosec.NewConfig())).NotTo(Succeed())
+	})
+})
@@ -15,11 +15,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 
@@ -55,6 +58,10 @@ USAGE:
 	$ gosec -exclude=G101 $GOPATH/src/github.com/example/project/...
 
 `
+	// exitIssues is the exit code of the scans which found issues
+	exitIssues = 1
+	// exitErrors is the exit code of the scans which found Go errors with -fail-on-errors
+	exitErrors = 2
 )
 
 type arrayFlags []string
@@ -73,13 +80,13 @@ var (
 	flagIgnoreNoSec = flag.Bool("nosec", false, "Ignores #nosec comments when set")
 
 	// format output
-	flagFormat = flag.String("fmt", "text", "Set output format. Valid options are: json, yaml, csv, junit-xml, html, sonarqube, golint, sarif or text")
+	flagFormats arrayFlags
 
 	// #nosec alternative tag
 	flagAlternativeNoSec = flag.String("nosec-tag", "", "Set an alternative string for #nosec. Some examples: #dontanalyze, #falsepositive")
 
 	// output file
-	flagOutput = flag.String("out", "", "Set output file for results")
+	flagOutputs arrayFlags
 
 	// config file
 	flagConfig = flag.String("conf", "", "Path to optional config file")
@@ -111,12 +118,30 @@ var (
 	// do not fail
 	flagNoFail = flag.Bool("no-fail", false, "Do not fail the scanning, even if issues were found")
 
+	// fail with a distinct exit code when Go errors were found
+	flagFailOnErrors = flag.Bool("fail-on-errors", false, fmt.Sprintf("Exit with code %d when the Go packages have parse or type errors, the issues found exit with code %d", exitErrors, exitIssues))
+
+	// stop scanning after a number of issues
+	flagMaxIssues = flag.Int("max-issues", 0, "Stop the scan once the given number of issues were found (0 means no limit)")
+
+	// stop scanning after a duration
+	flagTimeout = flag.Duration("timeout", 0, "Stop the scan after the given duration, e.g. 10m, and report the results found so far (0 means no timeout)")
+
+	// render the file paths relative to a directory
+	flagOutRelativeTo = flag.String("out-relative-to", "", "Render the file paths in all the reports relative to the given directory (default the root of the Go module of the working directory)")
+
+	// category of the runs in the SARIF reports
+	flagSarifCategory = flag.String("sarif-category", output.DefaultSarifCategory, "Set the category of the runs in the SARIF reports, keeping apart the results of several analyses of a repository")
+
 	// scan tests files
 	flagScanTests = flag.Bool("tests", false, "Scan tests files")
 
 	// print version and quit with exit code 0
 	flagVersion = flag.Bool("version", false, "Print version and quit with exit code 0")
 
+	// list the available rules and quit with exit code 0
+	flagListRules = flag.Bool("list-rules", false, "Print the available rules with their severity, confidence and CWE, then quit. Use -fmt json for a JSON output")
+
 	// exlude the folders from scan
 	flagDirsExclude arrayFlags
 
@@ -187,7 +212,93 @@ func loadRules(include, exclude string) rules.RuleList {
 	return rules.Generate(filters...)
 }
 
-func saveOutput(filename, format string, color bool, paths []string, issues []*gosec.Issue, metrics *gosec.Metrics, errors map[string][]gosec.Error) error {
+// outputTarget is a report format along with the file it is written to,
+// an empty filename standing for stdout
+type outputTarget struct {
+	format   string
+	filename string
+}
+
+// outputTargets pairs the -fmt and -out flags in the order they were given.
+// The formats without a matching -out flag are written to stdout.
+func outputTargets(formats, filenames []string) ([]outputTarget, error) {
+	if len(formats) == 0 {
+		formats = []string{"text"}
+	}
+	if len(filenames) > len(formats) {
+		return nil, fmt.Errorf("%d output files given for %d formats, each -out flag requires a -fmt flag", len(filenames), len(formats))
+	}
+	targets := make([]outputTarget, 0, len(formats))
+	for i, format := range formats {
+		target := outputTarget{format: format}
+		if i < len(filenames) {
+			target.filename = filenames[i]
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// outputRoot returns the directory the paths in the reports are relative to,
+// defaulting to the root of the Go module of the working directory.
+func outputRoot(dir string) (string, error) {
+	if dir == "" {
+		return gosec.ModuleRoot(".")
+	}
+	return filepath.Abs(dir)
+}
+
+// issueStream is an ndjson report written while the scan is running
+type issueStream struct {
+	issues chan *gosec.Issue
+	done   chan error
+	file   *os.File
+}
+
+// startStreams starts writing the ndjson reports among the targets, and returns
+// the other targets which are written once the scan is done.
+func startStreams(targets []outputTarget, stdout io.Writer) ([]outputTarget, []*issueStream, error) {
+	var others []outputTarget
+	var streams []*issueStream
+	for _, target := range targets {
+		if target.format != "ndjson" {
+			others = append(others, target)
+			continue
+		}
+		stream := &issueStream{issues: make(chan *gosec.Issue), done: make(chan error, 1)}
+		w := stdout
+		if target.filename != "" {
+			file, err := os.Create(target.filename)
+			if err != nil {
+				for _, started := range streams {
+					_ = started.close()
+				}
+				return nil, nil, err
+			}
+			stream.file = file
+			w = file
+		}
+		go func() {
+			stream.done <- output.GenerateNDJSON(w, stream.issues)
+		}()
+		streams = append(streams, stream)
+	}
+	return others, streams, nil
+}
+
+// close waits for all the issues to be written to the report
+func (s *issueStream) close() error {
+	close(s.issues)
+	err := <-s.done
+	if s.file != nil {
+		if closeErr := s.file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func saveOutputs(targets []outputTarget, stdout io.Writer, paths []string, issues []*gosec.Issue, metrics *gosec.Metrics, errors map[string][]gosec.Error) error {
 	rootPaths := []string{}
 	for _, path := range paths {
 		rootPath, err := gosec.RootPath(path)
@@ -196,25 +307,43 @@ func saveOutput(filename, format string, color bool, paths []string, issues []*g
 		}
 		rootPaths = append(rootPaths, rootPath)
 	}
-	if filename != "" {
-		outfile, err := os.Create(filename)
-		if err != nil {
-			return err
-		}
-		defer outfile.Close() // #nosec G307
-		err = output.CreateReport(outfile, format, color, rootPaths, issues, metrics, errors)
-		if err != nil {
-			return err
-		}
-	} else {
-		err := output.CreateReport(os.Stdout, format, color, rootPaths, issues, metrics, errors)
-		if err != nil {
+	for _, target := range targets {
+		if err := saveOutput(target, stdout, rootPaths, issues, metrics, errors); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func saveOutput(target outputTarget, stdout io.Writer, rootPaths []string, issues []*gosec.Issue, metrics *gosec.Metrics, errors map[string][]gosec.Error) error {
+	// Color flag is allowed for text format
+	color := target.format == "text"
+	opts := output.ReportOptions{SarifCategory: *flagSarifCategory}
+	if target.filename == "" {
+		return output.CreateReportWithOptions(stdout, target.format, color, rootPaths, opts, issues, metrics, errors)
+	}
+	outfile, err := os.Create(target.filename)
+	if err != nil {
+		return err
+	}
+	defer outfile.Close() // #nosec G307
+	return output.CreateReportWithOptions(outfile, target.format, color, rootPaths, opts, issues, metrics, errors)
+}
+
+// exitCode returns the exit code of the scan given the number of issues found.
+// The Go errors fail the scan like the issues, and with failOnErrors they get
+// their own code so that the scans of code which does not build can be told
+// apart from the scans finding issues.
+func exitCode(found int, errors map[string][]gosec.Error, scanErr error, noFail, failOnErrors bool) int {
+	if failOnErrors && len(errors) > 0 {
+		return exitErrors
+	}
+	if (found > 0 || len(errors) > 0 || scanErr != nil) && !noFail {
+		return exitIssues
+	}
+	return 0
+}
+
 func convertToScore(severity string) (gosec.Score, error) {
 	severity = strings.ToLower(severity)
 	switch severity {
@@ -229,14 +358,11 @@ func convertToScore(severity string) (gosec.Score, error) {
 	}
 }
 
-func filterIssues(issues []*gosec.Issue, severity gosec.Score, confidence gosec.Score) []*gosec.Issue {
-	result := []*gosec.Issue{}
-	for _, issue := range issues {
-		if issue.Severity >= severity && issue.Confidence >= confidence {
-			result = append(result, issue)
-		}
+// issueFilter selects the issues with at least the given severity and confidence
+func issueFilter(severity gosec.Score, confidence gosec.Score) func(*gosec.Issue) bool {
+	return func(issue *gosec.Issue) bool {
+		return issue.Severity >= severity && issue.Confidence >= confidence
 	}
-	return result
 }
 
 func main() {
@@ -246,6 +372,10 @@ func main() {
 	// Setup usage description
 	flag.Usage = usage
 
+	// Setup the output formats and files
+	flag.Var(&flagFormats, "fmt", "Set output format. Valid options are: json, ndjson, yaml, csv, junit-xml, html, sonarqube, golint, sarif or text. Can be repeated along with -out to produce several reports (default text)")
+	flag.Var(&flagOutputs, "out", "Set output file for results of the matching -fmt flag, the reports without an output file are written to stdout")
+
 	// Setup the excluded folders from scan
 	flag.Var(&flagDirsExclude, "exclude-dir", "Exclude folder from scan (can be specified multiple times)")
 	err := flag.Set("exclude-dir", "vendor")
@@ -265,6 +395,24 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *flagListRules {
+		logger = log.New(ioutil.Discard, "", 0)
+		config, err := loadConfig(*flagConfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "\nError: %v\n", err) // #nosec
+			os.Exit(1)
+		}
+		format := "text"
+		if len(flagFormats) > 0 {
+			format = flagFormats[0]
+		}
+		if err := listRules(os.Stdout, format, loadRules(*flagRulesInclude, *flagRulesExclude), config); err != nil {
+			fmt.Fprintf(os.Stderr, "\nError: %v\n", err) // #nosec
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Ensure at least one file was specified
 	if flag.NArg() == 0 {
 		fmt.Fprintf(os.Stderr, "\nError: FILE [FILE...] or './...' expected\n") // #nosec
@@ -289,10 +437,9 @@ func main() {
 		logger = log.New(logWriter, "[gosec] ", log.LstdFlags)
 	}
 
-	// Color flag is allowed for text format
-	var color bool
-	if *flagFormat == "text" {
-		color = true
+	targets, err := outputTargets(flagFormats, flagOutputs)
+	if err != nil {
+		logger.Fatal(err)
 	}
 
 	failSeverity, err := convertToScore(*flagSeverity)
@@ -319,6 +466,9 @@ func main() {
 
 	// Create the analyzer
 	analyzer := gosec.NewAnalyzer(config, *flagScanTests, logger)
+	// The issues filtered out by severity or confidence do not count towards the limit
+	analyzer.SetIssueFilter(issueFilter(failSeverity, failConfidence))
+	analyzer.SetMaxIssues(*flagMaxIssues)
 	analyzer.LoadRules(ruleDefinitions.Builders())
 
 	excludedDirs := gosec.ExcludedDirsRegExp(flagDirsExclude)
@@ -339,9 +489,43 @@ func main() {
 		buildTags = strings.Split(*flagBuildTags, ",")
 	}
 
-	if err := analyzer.Process(buildTags, packages...); err != nil {
+	ctx := context.Background()
+	if *flagTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *flagTimeout)
+		defer cancel()
+	}
+
+	// The ndjson reports are written while scanning
+	relativeTo, err := outputRoot(*flagOutRelativeTo)
+	if err != nil {
 		logger.Fatal(err)
 	}
+	targets, streams, err := startStreams(targets, os.Stdout)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	// The issues are not kept when they are only streamed, only their count
+	if len(streams) > 0 {
+		analyzer.SetDiscardIssues(len(targets) == 0)
+		analyzer.SetIssueHandler(func(issue *gosec.Issue) {
+			relIssues, _ := output.RelativeTo(relativeTo, []*gosec.Issue{issue}, nil)
+			for _, stream := range streams {
+				stream.issues <- relIssues[0]
+			}
+		})
+	}
+
+	// An interrupted scan still reports the issues found so far
+	scanErr := analyzer.ProcessWithContext(ctx, buildTags, packages...)
+	if scanErr != nil {
+		logger.Printf("%v, reporting partial results", scanErr)
+	}
+	for _, stream := range streams {
+		if err := stream.close(); err != nil {
+			logger.Fatal(err)
+		}
+	}
 
 	// Collect the results
 	issues, metrics, errors := analyzer.Report()
@@ -351,19 +535,19 @@ func main() {
 		sortIssues(issues)
 	}
 
-	// Filter the issues by severity and confidence
-	issues = filterIssues(issues, failSeverity, failConfidence)
-	if metrics.NumFound != len(issues) {
-		metrics.NumFound = len(issues)
-	}
+	// The issues were filtered by severity and confidence while scanning, and the
+	// metrics count the streamed issues which are not kept
+	found := metrics.NumFound
 
 	// Exit quietly if nothing was found
-	if len(issues) == 0 && *flagQuiet {
-		os.Exit(0)
+	if found == 0 && *flagQuiet {
+		os.Exit(exitCode(0, errors, scanErr, *flagNoFail, *flagFailOnErrors))
 	}
 
 	// Create output report
-	if err := saveOutput(*flagOutput, *flagFormat, color, flag.Args(), issues, metrics, errors); err != nil {
+	issues, errors = output.RelativeTo(relativeTo, issues, errors)
+
+	if err := saveOutputs(targets, os.Stdout, flag.Args(), issues, metrics, errors); err != nil {
 		logger.Fatal(err)
 	}
 
@@ -371,7 +555,5 @@ func main() {
 	logWriter.Close() // #nosec
 
 	// Do we have an issue? If so exit 1 unless NoFail is set
-	if (len(issues) > 0 || len(errors) > 0) && !*flagNoFail {
-		os.Exit(1)
-	}
+	os.Exit(exitCode(found, errors, scanErr, *flagNoFail, *flagFailOnErrors))
 }
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/gosec/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output targets", func() {
+	It("writes to stdout in text format by default", func() {
+		targets, err := outputTargets(nil, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(targets).To(Equal([]outputTarget{{format: "text"}}))
+	})
+
+	It("pairs the formats with the output files in order", func() {
+		targets, err := outputTargets([]string{"sarif", "text"}, []string{"results.sarif"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(targets).To(Equal([]outputTarget{
+			{format: "sarif", filename: "results.sarif"},
+			{format: "text"},
+		}))
+	})
+
+	It("rejects output files without a format", func() {
+		_, err := outputTargets([]string{"json"}, []string{"a.json", "b.json"})
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("produces several reports from a single scan", func() {
+		dir, err := os.MkdirTemp("", "gosec-output")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		sarifFile := filepath.Join(dir, "results.sarif")
+
+		issue := createIssue()
+		issues := []*gosec.Issue{&issue}
+		metrics := &gosec.Metrics{NumFiles: 1, NumFound: 1}
+		targets := []outputTarget{{format: "text"}, {format: "sarif", filename: sarifFile}}
+
+		stdout := new(bytes.Buffer)
+		err = saveOutputs(targets, stdout, []string{dir}, issues, metrics, map[string][]gosec.Error{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(stdout.String()).To(ContainSubstring("ruleID"))
+		Expect(stdout.String()).To(ContainSubstring("Files: 1"))
+
+		data, err := os.ReadFile(sarifFile)
+		Expect(err).ShouldNot(HaveOccurred())
+		var sarif map[string]interface{}
+		Expect(json.Unmarshal(data, &sarif)).To(Succeed())
+		Expect(sarif).To(HaveKey("runs"))
+	})
+
+	It("streams the ndjson reports while the other reports wait for the scan", func() {
+		stdout := new(bytes.Buffer)
+		targets, streams, err := startStreams([]outputTarget{{format: "ndjson"}, {format: "sarif"}}, stdout)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(targets).To(Equal([]outputTarget{{format: "sarif"}}))
+		Expect(streams).To(HaveLen(1))
+
+		issue := createIssue()
+		streams[0].issues <- &issue
+		Expect(streams[0].close()).To(Succeed())
+
+		var streamed map[string]interface{}
+		Expect(json.Unmarshal(stdout.Bytes(), &streamed)).To(Succeed())
+		Expect(streamed).To(HaveKeyWithValue("rule_id", issue.RuleID))
+	})
+})
@@ -6,13 +6,20 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"path"
+	"path/filepath"
 	"sort"
+	"strings"
 )
 
 const (
 	// Globals are applicable to all rules and used for general
 	// configuration settings for gosec.
 	Globals = "global"
+	// IncludePaths is the setting of a rule with the path patterns it runs on
+	IncludePaths = "include"
+	// ExcludePaths is the setting of a rule with the path patterns it skips
+	ExcludePaths = "exclude"
 )
 
 // GlobalOption defines the name of the global options
@@ -25,6 +32,9 @@ const (
 	Audit GlobalOption = "audit"
 	// NoSecAlternative global option alternative for #nosec directive
 	NoSecAlternative GlobalOption = "#nosec"
+	// Generated global option with a comma separated list of the rules which
+	// also run on generated code, including the protobuf files
+	Generated GlobalOption = "generated"
 )
 
 // Config is used to provide configuration and customization to each of the rules.
@@ -129,3 +139,123 @@ func (c Config) IsGlobalEnabled(option GlobalOption) (bool, error) {
 	}
 	return (value == "true" || value == "enabled"), nil
 }
+
+// GeneratedCodeRules returns the IDs of the rules which should also run on
+// generated files, as configured with the Generated global option.
+func (c Config) GeneratedCodeRules() map[string]bool {
+	rules := make(map[string]bool)
+	value, err := c.GetGlobal(Generated)
+	if err != nil {
+		return rules
+	}
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			rules[id] = true
+		}
+	}
+	return rules
+}
+
+// ruleApplies returns true if the rule should run on the file according to the
+// "include" and "exclude" path patterns of its configuration, for example:
+//
+//	{"G706": {"include": ["x/*/keeper/"], "exclude": ["*_test.go"]}}
+//
+// A pattern matches consecutive elements of the file path using path.Match,
+// and only the directories when it ends with a slash. The rule runs on every
+// file when no pattern is included, and the exclusions take precedence. The
+// paths are checked before the rule runs, so the rules restricting themselves
+// further, e.g. with the "scope" of the Cosmos SDK rules, only see the files
+// matching both.
+func ruleApplies(ruleID, file string, conf Config) bool {
+	paths, ok := rulePaths(ruleID, conf)
+	return !ok || paths.applies(file)
+}
+
+// pathPatterns holds the "include" and "exclude" path patterns of a rule
+type pathPatterns struct {
+	include []string
+	exclude []string
+}
+
+// rulePaths returns the path patterns configured for the rule, and false when
+// the rule runs on every file.
+func rulePaths(ruleID string, conf Config) (pathPatterns, bool) {
+	settings, ok := conf[ruleID].(map[string]interface{})
+	if !ok {
+		return pathPatterns{}, false
+	}
+	paths := pathPatterns{
+		include: toPatterns(settings[IncludePaths]),
+		exclude: toPatterns(settings[ExcludePaths]),
+	}
+	return paths, len(paths.include) > 0 || len(paths.exclude) > 0
+}
+
+// applies returns true if the file is included and not excluded by the patterns
+func (p pathPatterns) applies(file string) bool {
+	file = filepath.ToSlash(file)
+	for _, pattern := range p.exclude {
+		if matchesPath(pattern, file) {
+			return false
+		}
+	}
+	if len(p.include) == 0 {
+		return true
+	}
+	for _, pattern := range p.include {
+		if matchesPath(pattern, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPathSetting returns true for the keys of the rule settings holding the path
+// patterns, which the rules configured with a map of packages have to skip.
+func IsPathSetting(key string) bool {
+	return key == IncludePaths || key == ExcludePaths
+}
+
+func toPatterns(value interface{}) []string {
+	switch patterns := value.(type) {
+	case string:
+		return []string{patterns}
+	case []string:
+		return patterns
+	case []interface{}:
+		var result []string
+		for _, pattern := range patterns {
+			if pattern, ok := pattern.(string); ok {
+				result = append(result, pattern)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// matchesPath returns true if the elements of the pattern match consecutive
+// elements of the slash separated file path.
+func matchesPath(pattern, file string) bool {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	patternElems := strings.Split(strings.Trim(pattern, "/"), "/")
+	elems := strings.Split(strings.Trim(file, "/"), "/")
+	if dirOnly {
+		elems = elems[:len(elems)-1]
+	}
+	for start := 0; start+len(patternElems) <= len(elems); start++ {
+		matched := true
+		for i, patternElem := range patternElems {
+			if ok, err := path.Match(patternElem, elems[start+i]); err != nil || !ok {
+				matched = false
+				break
+			}
+		}
+		// the patterns of files have to match up to the file name
+		if matched && (dirOnly || start+len(patternElems) == len(elems)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package gosec
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Rule paths", func() {
+	ginkgo.It("should run the rules on the included paths which are not excluded", func() {
+		conf := Config{
+			"G706": map[string]interface{}{
+				"include": []interface{}{"x/*/keeper/"},
+				"exclude": []interface{}{"*_test.go"},
+			},
+			"G101": map[string]interface{}{
+				"pattern": "(?i)secret",
+			},
+		}
+		Expect(ruleApplies("G706", "/home/src/chain/x/bank/keeper/msg_server.go", conf)).To(BeTrue())
+		Expect(ruleApplies("G706", "/home/src/chain/x/bank/keeper/msg_server_test.go", conf)).To(BeFalse())
+		Expect(ruleApplies("G706", "/home/src/chain/x/bank/types/msgs.go", conf)).To(BeFalse())
+		Expect(ruleApplies("G706", "/home/src/chain/cmd/chaind/main.go", conf)).To(BeFalse())
+		Expect(ruleApplies("G706", "/home/src/chain/x/keeper.go", conf)).To(BeFalse())
+		Expect(ruleApplies("G101", "/home/src/chain/cmd/chaind/main.go", conf)).To(BeTrue())
+		Expect(ruleApplies("G102", "/home/src/chain/cmd/chaind/main.go", conf)).To(BeTrue())
+	})
+
+	ginkgo.It("should match the file patterns up to the file name", func() {
+		conf := Config{
+			"G104": map[string]interface{}{
+				"include": []string{"keeper/*.go"},
+			},
+		}
+		Expect(ruleApplies("G104", "/src/x/bank/keeper/keeper.go", conf)).To(BeTrue())
+		Expect(ruleApplies("G104", "/src/x/bank/keeper/migrations/v2.go", conf)).To(BeFalse())
+	})
+
+	ginkgo.It("should read the paths next to the packages configured on a rule", func() {
+		conf := Config{
+			"G104": map[string]interface{}{
+				"exclude": []interface{}{"keeper/"},
+				"io":      []interface{}{"Copy"},
+			},
+		}
+		Expect(ruleApplies("G104", "/src/x/bank/keeper/keeper.go", conf)).To(BeFalse())
+		Expect(ruleApplies("G104", "/src/x/bank/types/msgs.go", conf)).To(BeTrue())
+		Expect(IsPathSetting("exclude")).To(BeTrue())
+		Expect(IsPathSetting("io")).To(BeFalse())
+	})
+})
@@ -138,5 +138,19 @@ var _ = Describe("Configuration", func() {
 			Expect(err).Should(BeNil())
 			Expect(value).Should(Equal("true"))
 		})
+		It("should parse the rules enabled on generated code", func() {
+			config := `
+			{
+				"global": {
+					"generated": "G401, G701,"
+				}
+			}`
+			cfg := gosec.NewConfig()
+			_, err := cfg.ReadFrom(strings.NewReader(config))
+			Expect(err).Should(BeNil())
+
+			Expect(cfg.GeneratedCodeRules()).Should(Equal(map[string]bool{"G401": true, "G701": true}))
+			Expect(gosec.NewConfig().GeneratedCodeRules()).Should(BeEmpty())
+		})
 	})
 })
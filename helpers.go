@@ -28,6 +28,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // MatchCallByPackage ensures that the specified package is imported,
@@ -118,6 +121,18 @@ func GetCallObject(n ast.Node, ctx *Context) (*ast.CallExpr, types.Object) {
 	return nil, nil
 }
 
+// GetCallFullName returns the fully qualified name of the function or method
+// called by the node, for example "encoding/json.Unmarshal" or
+// "(*encoding/json.Decoder).Decode". Calls to builtins, conversions and
+// function values are not resolved.
+func GetCallFullName(n ast.Node, ctx *Context) (string, bool) {
+	_, obj := GetCallObject(n, ctx)
+	if fn, ok := obj.(*types.Func); ok {
+		return fn.FullName(), true
+	}
+	return "", false
+}
+
 // GetCallInfo returns the package or type and name  associated with a
 // call expression.
 func GetCallInfo(n ast.Node, ctx *Context) (string, string, error) {
@@ -287,6 +302,40 @@ func GetLocation(n ast.Node, ctx *Context) (string, int) {
 	return fobj.Name(), fobj.Line(n.Pos())
 }
 
+// GetEnclosingFuncDecl returns the function declaration which contains the
+// given node, or nil if the node is declared at package level.
+func GetEnclosingFuncDecl(n ast.Node, ctx *Context) *ast.FuncDecl {
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, n.Pos(), n.End())
+	for _, p := range path {
+		if fn, ok := p.(*ast.FuncDecl); ok {
+			return fn
+		}
+	}
+	return nil
+}
+
+// IsInsideLoop returns true if the node is executed on every iteration of a
+// for or range loop of its enclosing function. Function literals declared in
+// a loop are not considered part of the loop.
+func IsInsideLoop(n ast.Node, ctx *Context) bool {
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, n.Pos(), n.End())
+	for _, p := range path {
+		switch loop := p.(type) {
+		case *ast.ForStmt:
+			if loop.Body != nil && loop.Body.Pos() <= n.Pos() {
+				return true
+			}
+		case *ast.RangeStmt:
+			if loop.Body != nil && loop.Body.Pos() <= n.Pos() {
+				return true
+			}
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+	return false
+}
+
 // Gopath returns all GOPATHs
 func Gopath() []string {
 	defaultGoPath := runtime.GOROOT()
@@ -459,3 +508,40 @@ func RootPath(root string) (string, error) {
 	}
 	return filepath.Abs(root)
 }
+
+// ModuleRoot returns the absolute path of the deepest directory containing a
+// go.mod file among the given directory and its parents, defaulting to the
+// directory itself when there is none.
+func ModuleRoot(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for p := absDir; ; p = filepath.Dir(p) {
+		if info, err := os.Stat(filepath.Join(p, "go.mod")); err == nil && !info.IsDir() {
+			return p, nil
+		}
+		if filepath.Dir(p) == p {
+			return absDir, nil
+		}
+	}
+}
+
+// SnakeCase splits an identifier on its camel case boundaries and joins its
+// lower cased words with underscores, e.g. expectedHMACSig becomes
+// expected_hmac_sig, so that the words of the names can be matched.
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, c := range runes {
+		if i > 0 && unicode.IsUpper(c) && runes[i-1] != '_' {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
@@ -76,6 +76,26 @@ var _ = Describe("Helpers", func() {
 		})
 	})
 
+	Context("when getting the module root", func() {
+		It("should return the deepest directory with a go.mod file", func() {
+			cwd, err := os.Getwd()
+			Expect(err).ShouldNot(HaveOccurred())
+			root, err := gosec.ModuleRoot(filepath.Join("output", "testdata"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(root).Should(Equal(cwd))
+		})
+	})
+
+	Context("when converting the identifiers to snake case", func() {
+		It("should split the words on the camel case boundaries", func() {
+			Expect(gosec.SnakeCase("rpcPort")).Should(Equal("rpc_port"))
+			Expect(gosec.SnakeCase("expectedHMACSig")).Should(Equal("expected_hmac_sig"))
+			Expect(gosec.SnakeCase("ChainID")).Should(Equal("chain_id"))
+			Expect(gosec.SnakeCase("listen_Port2")).Should(Equal("listen_port2"))
+			Expect(gosec.SnakeCase("transport")).Should(Equal("transport"))
+		})
+	})
+
 	Context("when excluding the dirs", func() {
 		It("should create a proper regexp", func() {
 			r := gosec.ExcludedDirsRegExp([]string{"test"})
@@ -229,6 +249,40 @@ var _ = Describe("Helpers", func() {
 			Expect(result).Should(HaveKeyWithValue("fmt", "Println"))
 		})
 	})
+	Context("when getting the full name of a call", func() {
+		It("should resolve functions and methods of chained calls", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("main.go", `
+			package main
+
+			import(
+			    "bytes"
+			    enc "encoding/json"
+			)
+
+			func main() {
+				var v struct{}
+				_ = enc.Unmarshal([]byte("{}"), &v)
+				_ = enc.NewDecoder(new(bytes.Buffer)).Decode(&v)
+				println("builtin")
+			}
+			`)
+			ctx := pkg.CreateContext("main.go")
+			result := []string{}
+			visitor := testutils.NewMockVisitor()
+			visitor.Context = ctx
+			visitor.Callback = func(n ast.Node, ctx *gosec.Context) bool {
+				if name, ok := gosec.GetCallFullName(n, ctx); ok {
+					result = append(result, name)
+				}
+				return true
+			}
+			ast.Walk(visitor, ctx.Root)
+
+			Expect(result).Should(ConsistOf("encoding/json.Unmarshal", "(*encoding/json.Decoder).Decode", "encoding/json.NewDecoder"))
+		})
+	})
 	Context("when getting binary expression operands", func() {
 		It("should return all operands of a binary experssion", func() {
 			pkg := testutils.NewTestPackage()
@@ -293,4 +347,83 @@ var _ = Describe("Helpers", func() {
 			Expect(len(operands)).Should(Equal(4))
 		})
 	})
+
+	Context("when getting the enclosing function", func() {
+		It("should return the declaration enclosing a node", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("main.go", `
+			package main
+
+			var global = println
+
+			func main() {
+				func() {
+					println("nested")
+				}()
+			}
+			`)
+			ctx := pkg.CreateContext("main.go")
+			enclosing := map[string]string{}
+			visitor := testutils.NewMockVisitor()
+			visitor.Context = ctx
+			visitor.Callback = func(n ast.Node, ctx *gosec.Context) bool {
+				if ident, ok := n.(*ast.Ident); ok && ident.Name == "println" {
+					name := ""
+					if fn := gosec.GetEnclosingFuncDecl(ident, ctx); fn != nil {
+						name = fn.Name.Name
+					}
+					enclosing[ctx.FileSet.Position(ident.Pos()).String()] = name
+				}
+				return true
+			}
+			ast.Walk(visitor, ctx.Root)
+
+			Expect(enclosing).Should(HaveLen(2))
+			Expect(enclosing).Should(ContainElement(""))
+			Expect(enclosing).Should(ContainElement("main"))
+		})
+	})
+
+	Context("when checking if a node is inside a loop", func() {
+		It("should only consider the loop bodies of the enclosing function", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("main.go", `
+			package main
+
+			func main() {
+				println("before")
+				for i := 0; i < len("cond"); i++ {
+					println("for")
+				}
+				for range []int{1} {
+					func() {
+						println("closure")
+					}()
+				}
+			}
+			`)
+			ctx := pkg.CreateContext("main.go")
+			inLoop := map[string]bool{}
+			visitor := testutils.NewMockVisitor()
+			visitor.Context = ctx
+			visitor.Callback = func(n ast.Node, ctx *gosec.Context) bool {
+				if call, ok := n.(*ast.CallExpr); ok && len(call.Args) == 1 {
+					if lit, ok := call.Args[0].(*ast.BasicLit); ok {
+						inLoop[lit.Value] = gosec.IsInsideLoop(call, ctx)
+					}
+				}
+				return true
+			}
+			ast.Walk(visitor, ctx.Root)
+
+			Expect(inLoop).Should(Equal(map[string]bool{
+				`"before"`:  false,
+				`"cond"`:    false,
+				`"for"`:     true,
+				`"closure"`: false,
+			}))
+		})
+	})
 })
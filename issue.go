@@ -63,6 +63,30 @@ var IssueToCWE = map[string]Cwe{
 	"G108": GetCwe("200"),
 	"G109": GetCwe("190"),
 	"G110": GetCwe("409"),
+	"G111": GetCwe("703"),
+	"G113": GetCwe("705"),
+	"G114": GetCwe("694"),
+	"G115": GetCwe("404"),
+	"G119": GetCwe("772"),
+	"G120": GetCwe("252"),
+	"G121": GetCwe("755"),
+	"G122": GetCwe("129"),
+	"G126": GetCwe("190"),
+	"G127": GetCwe("667"),
+	"G129": GetCwe("705"),
+	"G132": GetCwe("667"),
+	"G133": GetCwe("476"),
+	"G135": GetCwe("369"),
+	"G136": GetCwe("478"),
+	"G137": GetCwe("190"),
+	"G138": GetCwe("404"),
+	"G139": GetCwe("798"),
+	"G141": GetCwe("703"),
+	"G142": GetCwe("242"),
+	"G143": GetCwe("178"),
+	"G145": GetCwe("252"),
+	"G146": GetCwe("835"),
+	"G148": GetCwe("1025"),
 	"G201": GetCwe("89"),
 	"G202": GetCwe("89"),
 	"G203": GetCwe("79"),
@@ -74,16 +98,27 @@ var IssueToCWE = map[string]Cwe{
 	"G305": GetCwe("22"),
 	"G306": GetCwe("276"),
 	"G307": GetCwe("703"),
+	"G308": GetCwe("703"),
+	"G309": GetCwe("22"),
+	"G310": GetCwe("276"),
 	"G401": GetCwe("326"),
 	"G402": GetCwe("295"),
 	"G403": GetCwe("310"),
 	"G404": GetCwe("338"),
+	"G405": GetCwe("208"),
+	"G406": GetCwe("252"),
+	"G407": GetCwe("330"),
 	"G501": GetCwe("327"),
 	"G502": GetCwe("327"),
 	"G503": GetCwe("327"),
 	"G504": GetCwe("327"),
 	"G505": GetCwe("327"),
+	"G506": GetCwe("477"),
 	"G601": GetCwe("118"),
+	"G602": GetCwe("119"),
+	"G603": GetCwe("118"),
+	"G604": GetCwe("118"),
+	"G606": GetCwe("682"),
 }
 
 // Issue is returned by a gosec rule if it discovers an issue with the scanned code.
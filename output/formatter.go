@@ -85,9 +85,22 @@ type reportInfo struct {
 	Stats  *gosec.Metrics
 }
 
+// ReportOptions holds the settings of the reports which only apply to some of
+// the formats
+type ReportOptions struct {
+	// SarifCategory is the category of the SARIF runs, DefaultSarifCategory when empty
+	SarifCategory string
+}
+
 // CreateReport generates a report based for the supplied issues and metrics given
-// the specified format. The formats currently accepted are: json, yaml, csv, junit-xml, html, sonarqube, golint and text.
+// the specified format. The formats currently accepted are: json, ndjson, yaml, csv, junit-xml, html, sonarqube, golint, sarif and text.
 func CreateReport(w io.Writer, format string, enableColor bool, rootPaths []string, issues []*gosec.Issue, metrics *gosec.Metrics, errors map[string][]gosec.Error) error {
+	return CreateReportWithOptions(w, format, enableColor, rootPaths, ReportOptions{}, issues, metrics, errors)
+}
+
+// CreateReportWithOptions generates a report like CreateReport, with the given
+// options for the formats supporting them.
+func CreateReportWithOptions(w io.Writer, format string, enableColor bool, rootPaths []string, opts ReportOptions, issues []*gosec.Issue, metrics *gosec.Metrics, errors map[string][]gosec.Error) error {
 	data := &reportInfo{
 		Errors: errors,
 		Issues: issues,
@@ -97,6 +110,8 @@ func CreateReport(w io.Writer, format string, enableColor bool, rootPaths []stri
 	switch format {
 	case "json":
 		err = reportJSON(w, data)
+	case "ndjson":
+		err = reportNDJSON(w, data)
 	case "yaml":
 		err = reportYAML(w, data)
 	case "csv":
@@ -112,7 +127,7 @@ func CreateReport(w io.Writer, format string, enableColor bool, rootPaths []stri
 	case "golint":
 		err = reportGolint(w, data)
 	case "sarif":
-		err = reportSARIFTemplate(rootPaths, w, data)
+		err = reportSARIFTemplate(rootPaths, opts.SarifCategory, w, data)
 	default:
 		err = reportFromPlaintextTemplate(w, text, enableColor, data)
 	}
@@ -135,13 +150,7 @@ func reportSonarqube(rootPaths []string, w io.Writer, data *reportInfo) error {
 func convertToSonarIssues(rootPaths []string, data *reportInfo) (*sonarIssues, error) {
 	si := &sonarIssues{[]sonarIssue{}}
 	for _, issue := range data.Issues {
-		var sonarFilePath string
-		for _, rootPath := range rootPaths {
-			if strings.HasPrefix(issue.File, rootPath) {
-				sonarFilePath = strings.Replace(issue.File, rootPath+"/", "", 1)
-			}
-		}
-
+		sonarFilePath := relativePath(issue.File, rootPaths)
 		if sonarFilePath == "" {
 			continue
 		}
@@ -177,7 +186,7 @@ func convertToSonarIssues(rootPaths []string, data *reportInfo) (*sonarIssues, e
 	return si, nil
 }
 
-func convertToSarifReport(rootPaths []string, data *reportInfo) (*sarifReport, error) {
+func convertToSarifReport(rootPaths []string, category string, data *reportInfo) (*sarifReport, error) {
 	sr := buildSarifReport()
 
 	type rule struct {
@@ -228,8 +237,9 @@ func convertToSarifReport(rootPaths []string, data *reportInfo) (*sarifReport, e
 	}
 
 	run := &sarifRun{
-		Tool:    tool,
-		Results: results,
+		Tool:              tool,
+		AutomationDetails: buildSarifAutomationDetails(category),
+		Results:           results,
 	}
 
 	sr.Runs = append(sr.Runs, run)
@@ -323,8 +333,8 @@ func reportJUnitXML(w io.Writer, data *reportInfo) error {
 	return nil
 }
 
-func reportSARIFTemplate(rootPaths []string, w io.Writer, data *reportInfo) error {
-	sr, err := convertToSarifReport(rootPaths, data)
+func reportSARIFTemplate(rootPaths []string, category string, w io.Writer, data *reportInfo) error {
+	sr, err := convertToSarifReport(rootPaths, category, data)
 	if err != nil {
 		return err
 	}
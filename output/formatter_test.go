@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/cosmos/gosec/v2"
@@ -272,6 +273,88 @@ var _ = Describe("Formatter", func() {
 
 		})
 	})
+	Context("When streaming the issues as ndjson", func() {
+		It("writes one valid JSON object per issue and line", func() {
+			issues := make(chan *gosec.Issue)
+			go func() {
+				defer close(issues)
+				for _, rule := range []string{"G101", "G102", "G103"} {
+					issue := createIssue(rule, gosec.GetCwe(rule))
+					issues <- &issue
+				}
+			}()
+
+			buf := new(bytes.Buffer)
+			Expect(GenerateNDJSON(buf, issues)).To(Succeed())
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			Expect(lines).To(HaveLen(3))
+			for i, line := range lines {
+				var issue map[string]interface{}
+				Expect(json.Unmarshal([]byte(line), &issue)).To(Succeed())
+				Expect(issue).To(HaveKeyWithValue("rule_id", []string{"G101", "G102", "G103"}[i]))
+			}
+		})
+
+		It("writes the issues of a report", func() {
+			issue := createIssue("G101", gosec.GetCwe("G101"))
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "ndjson", false, []string{}, []*gosec.Issue{&issue, &issue}, &gosec.Metrics{}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(strings.Count(buf.String(), "\n")).To(Equal(2))
+		})
+	})
+
+	Context("When reporting SARIF runs in a category", func() {
+		It("emits the configured category in the automation details", func() {
+			issue := createIssue("G101", gosec.GetCwe("G101"))
+			buf := new(bytes.Buffer)
+			err := CreateReportWithOptions(buf, "sarif", false, []string{}, ReportOptions{SarifCategory: "gosec-app"}, []*gosec.Issue{&issue}, &gosec.Metrics{}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stripString(buf.String())).To(ContainSubstring(`"automationDetails":{"id":"gosec-app/"}`))
+		})
+
+		It("defaults to the gosec category", func() {
+			issue := createIssue("G101", gosec.GetCwe("G101"))
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "sarif", false, []string{}, []*gosec.Issue{&issue}, &gosec.Metrics{}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stripString(buf.String())).To(ContainSubstring(`"automationDetails":{"id":"gosec/"}`))
+		})
+	})
+
+	Context("When rendering the paths relative to a directory", func() {
+		It("renders the same relative paths in SARIF and JSON", func() {
+			issue := createIssue("G101", gosec.GetCwe("G101"))
+			issue.File = "/home/src/project/pkg/keeper/test.go"
+			errors := map[string][]gosec.Error{"/home/src/project/pkg/broken.go": {}}
+			issues, errors := RelativeTo("/home/src/project", []*gosec.Issue{&issue}, errors)
+			Expect(issue.File).To(Equal("/home/src/project/pkg/keeper/test.go"))
+			Expect(errors).To(HaveKey("pkg/broken.go"))
+
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "sarif", false, []string{"/home/src/project/pkg"}, issues, &gosec.Metrics{}, errors)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stripString(buf.String())).To(ContainSubstring(`"uri":"pkg/keeper/test.go"`))
+
+			buf = new(bytes.Buffer)
+			err = CreateReport(buf, "json", false, []string{"/home/src/project/pkg"}, issues, &gosec.Metrics{}, errors)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stripString(buf.String())).To(ContainSubstring(`"file":"pkg/keeper/test.go"`))
+		})
+
+		It("keeps the absolute paths outside of the directory", func() {
+			issue := createIssue("G101", gosec.GetCwe("G101"))
+			issues, _ := RelativeTo("/home/src/other", []*gosec.Issue{&issue}, nil)
+			Expect(issues[0].File).To(Equal("/home/src/project/test.go"))
+		})
+
+		It("renders the paths with forward slashes", func() {
+			Expect(relativePath(filepath.Join("/home", "src", "project", "pkg", "test.go"), []string{"/home/src/project"})).To(Equal("pkg/test.go"))
+			Expect(relativePath("/home/src/projectx/test.go", []string{"/home/src/project"})).To(BeEmpty())
+		})
+	})
+
 	Context("When using different report formats", func() {
 
 		grules := []string{"G101", "G102", "G103", "G104", "G106",
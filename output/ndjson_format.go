@@ -0,0 +1,34 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// GenerateNDJSON writes each issue received from the channel as a JSON object
+// on its own line, until the channel is closed. The issues are written as they
+// come without buffering the whole report, which keeps the memory flat on large
+// scans. The channel is drained even when writing fails.
+func GenerateNDJSON(w io.Writer, issues <-chan *gosec.Issue) error {
+	encoder := json.NewEncoder(w)
+	var err error
+	for issue := range issues {
+		if err == nil {
+			err = encoder.Encode(issue)
+		}
+	}
+	return err
+}
+
+func reportNDJSON(w io.Writer, data *reportInfo) error {
+	issues := make(chan *gosec.Issue)
+	go func() {
+		defer close(issues)
+		for _, issue := range data.Issues {
+			issues <- issue
+		}
+	}()
+	return GenerateNDJSON(w, issues)
+}
@@ -0,0 +1,51 @@
+package output
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// RelativeTo returns copies of the issues and errors whose file paths are made
+// relative to the given directory, so that every format renders the same paths.
+// The files outside of the directory keep their absolute path.
+func RelativeTo(dir string, issues []*gosec.Issue, errors map[string][]gosec.Error) ([]*gosec.Issue, map[string][]gosec.Error) {
+	relIssues := make([]*gosec.Issue, 0, len(issues))
+	for _, issue := range issues {
+		relIssue := *issue
+		if path := relativePath(issue.File, []string{dir}); path != "" {
+			relIssue.File = path
+		}
+		relIssues = append(relIssues, &relIssue)
+	}
+	relErrors := make(map[string][]gosec.Error, len(errors))
+	for file, fileErrors := range errors {
+		if path := relativePath(file, []string{dir}); path != "" {
+			file = path
+		}
+		relErrors[file] = fileErrors
+	}
+	return relIssues, relErrors
+}
+
+// relativePath returns the path of the file relative to the deepest of the root
+// paths containing it, with forward slashes as expected by SARIF and SonarQube.
+// The paths which are already relative are returned as they are, and an empty
+// string is returned when no root path contains the file.
+func relativePath(file string, rootPaths []string) string {
+	if !filepath.IsAbs(file) {
+		return filepath.ToSlash(file)
+	}
+	var relPath string
+	for _, rootPath := range rootPaths {
+		rel, err := filepath.Rel(rootPath, file)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if relPath == "" || len(rel) < len(relPath) {
+			relPath = rel
+		}
+	}
+	return filepath.ToSlash(relPath)
+}
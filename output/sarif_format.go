@@ -8,6 +8,9 @@ import (
 	"github.com/cosmos/gosec/v2"
 )
 
+// DefaultSarifCategory is the category of the SARIF runs when none is given
+const DefaultSarifCategory = "gosec"
+
 type sarifLevel string
 
 const (
@@ -78,9 +81,14 @@ type sarifTool struct {
 	Driver *sarifDriver `json:"driver"`
 }
 
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
 type sarifRun struct {
-	Tool    *sarifTool     `json:"tool"`
-	Results []*sarifResult `json:"results"`
+	Tool              *sarifTool              `json:"tool"`
+	AutomationDetails *sarifAutomationDetails `json:"automationDetails,omitempty"`
+	Results           []*sarifResult          `json:"results"`
 }
 
 type sarifReport struct {
@@ -89,6 +97,19 @@ type sarifReport struct {
 	Runs    []*sarifRun `json:"runs"`
 }
 
+// buildSarifAutomationDetails return the SARIF automation details of a run in the
+// given category. The category is the part of the id before its last slash, which
+// is added when missing.
+func buildSarifAutomationDetails(category string) *sarifAutomationDetails {
+	if category == "" {
+		category = DefaultSarifCategory
+	}
+	if !strings.HasSuffix(category, "/") {
+		category += "/"
+	}
+	return &sarifAutomationDetails{ID: category}
+}
+
 // buildSarifReport return SARIF report struct
 func buildSarifReport() *sarifReport {
 	return &sarifReport{
@@ -123,8 +144,6 @@ func buildSarifRule(issue *gosec.Issue) *sarifRule {
 
 // buildSarifLocation return SARIF location struct
 func buildSarifLocation(issue *gosec.Issue, rootPaths []string) (*sarifLocation, error) {
-	var filePath string
-
 	lines := strings.Split(issue.Line, "-")
 	startLine, err := strconv.ParseUint(lines[0], 10, 64)
 	if err != nil {
@@ -143,16 +162,10 @@ func buildSarifLocation(issue *gosec.Issue, rootPaths []string) (*sarifLocation,
 		return nil, err
 	}
 
-	for _, rootPath := range rootPaths {
-		if strings.HasPrefix(issue.File, rootPath) {
-			filePath = strings.Replace(issue.File, rootPath+"/", "", 1)
-		}
-	}
-
 	location := &sarifLocation{
 		PhysicalLocation: &sarifPhysicalLocation{
 			ArtifactLocation: &sarifArtifactLocation{
-				URI: filePath,
+				URI: relativePath(issue.File, rootPaths),
 			},
 			Region: &sarifRegion{
 				StartLine:   startLine,
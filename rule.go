@@ -15,6 +15,7 @@ package gosec
 import (
 	"go/ast"
 	"reflect" // #nosec G702
+	"sort"
 )
 
 // The Rule interface used by all rules supported by gosec.
@@ -57,3 +58,31 @@ func (r RuleSet) RegisteredFor(n ast.Node) []Rule {
 	}
 	return []Rule{}
 }
+
+// RegisteredIDsFor returns the ids of the rules that are registered for a
+// specified ast node, in the order they are run.
+func (r RuleSet) RegisteredIDsFor(n ast.Node) []string {
+	rules := r.RegisteredFor(n)
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID())
+	}
+	return ids
+}
+
+// AllRules returns every rule of the set once, sorted by id, no matter how
+// many ast nodes it is registered for.
+func (r RuleSet) AllRules() []Rule {
+	seen := make(map[Rule]bool)
+	all := []Rule{}
+	for _, rules := range r {
+		for _, rule := range rules {
+			if !seen[rule] {
+				seen[rule] = true
+				all = append(all, rule)
+			}
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID() < all[j].ID() })
+	return all
+}
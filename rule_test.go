@@ -84,6 +84,18 @@ var _ = Describe("Rule", func() {
 			Expect(ruleset.RegisteredFor(registeredNode)).Should(ContainElement(dummyIssueRule))
 		})
 
+		It("should list the ids of the rules registered for a node type", func() {
+			registeredNode := (*ast.CallExpr)(nil)
+			ruleset.Register(dummyIssueRule, registeredNode)
+			Expect(ruleset.RegisteredIDsFor(registeredNode)).Should(Equal([]string{"MOCK"}))
+			Expect(ruleset.RegisteredIDsFor((*ast.AssignStmt)(nil))).Should(BeEmpty())
+		})
+
+		It("should list every rule once", func() {
+			ruleset.Register(dummyIssueRule, (*ast.CallExpr)(nil), (*ast.AssignStmt)(nil))
+			Expect(ruleset.AllRules()).Should(Equal([]gosec.Rule{dummyIssueRule}))
+		})
+
 	})
 
 })
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type bigIntAliasing struct {
+	gosec.MetaData
+}
+
+func (r *bigIntAliasing) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *bigIntAliasing) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var dst *ast.Ident
+	var call *ast.CallExpr
+	switch stmt := n.(type) {
+	case *ast.AssignStmt:
+		if len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil, nil
+		}
+		dst, _ = stmt.Lhs[0].(*ast.Ident)
+		call, _ = stmt.Rhs[0].(*ast.CallExpr)
+	case *ast.ExprStmt:
+		call, _ = stmt.X.(*ast.CallExpr)
+	}
+	if call == nil {
+		return nil, nil
+	}
+	recv := bigReceiver(call, ctx)
+	if recv == nil {
+		return nil, nil
+	}
+	recvObj := ctx.Info.ObjectOf(recv)
+	fn := gosec.GetEnclosingFuncDecl(n, ctx)
+	if recvObj == nil || fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+
+	// the result is the receiver, both variables then point to the same value
+	// and the later changes of one silently change the other
+	if dst != nil && dst.Name != "_" {
+		dstObj := ctx.Info.ObjectOf(dst)
+		if dstObj != nil && dstObj != recvObj && usedAfter(fn.Body, dstObj, n, ctx) && usedAfter(fn.Body, recvObj, n, ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+
+	// the receiver is a parameter which is also an operand, the value of the
+	// caller is overwritten with the result
+	if !isParam(recvObj, fn, ctx) {
+		return nil, nil
+	}
+	for _, arg := range call.Args {
+		if usesAny(arg, map[types.Object]bool{recvObj: true}, ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// bigReceiver returns the variable receiving the call of a math/big method which
+// returns its receiver, e.g. x in x.Add(a, b).
+func bigReceiver(call *ast.CallExpr, ctx *gosec.Context) *ast.Ident {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	recv, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	selection, ok := ctx.Info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil
+	}
+	ptr, ok := selection.Recv().(*types.Pointer)
+	if !ok {
+		return nil
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "math/big" {
+		return nil
+	}
+	sig, ok := selection.Type().(*types.Signature)
+	if !ok || sig.Results().Len() != 1 || !types.Identical(sig.Results().At(0).Type(), ptr) {
+		return nil
+	}
+	return recv
+}
+
+func isParam(obj types.Object, fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			if ctx.Info.Defs[name] == obj {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewBigIntAliasing detects math/big results aliasing their receiver. The methods
+// of big.Int set their receiver and return it, so the variable assigned the result
+// and the receiver are the same value, and a parameter used both as receiver and
+// operand overwrites the value of the caller. A fresh new(big.Int) should be used
+// as the destination instead.
+func NewBigIntAliasing(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &bigIntAliasing{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Result of a big.Int method aliases its receiver, use new(big.Int) as the destination",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil)}
+}
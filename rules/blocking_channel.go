@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type blockingChannelOp struct {
+	gosec.MetaData
+}
+
+func (r *blockingChannelOp) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *blockingChannelOp) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch stmt := n.(type) {
+	case *ast.SendStmt:
+	case *ast.ExprStmt:
+		if unary, ok := stmt.X.(*ast.UnaryExpr); !ok || unary.Op != token.ARROW {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	// the path may start with the receive expression spanning the whole statement
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, n.Pos(), n.End())
+	for len(path) > 0 && path[0] != n {
+		path = path[1:]
+	}
+	if len(path) > 3 {
+		if clause, ok := path[1].(*ast.CommClause); ok && clause.Comm == n {
+			if selectStmt, ok := path[3].(*ast.SelectStmt); ok && handlesCancellation(selectStmt) {
+				return nil, nil
+			}
+		}
+	}
+	if !hasContextInScope(path, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// handlesCancellation returns true if the select has a default clause or a
+// case receiving from Done()
+func handlesCancellation(selectStmt *ast.SelectStmt) bool {
+	for _, stmt := range selectStmt.Body.List {
+		clause, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+		var recv ast.Expr
+		switch comm := clause.Comm.(type) {
+		case nil:
+			return true
+		case *ast.ExprStmt:
+			recv = comm.X
+		case *ast.AssignStmt:
+			if len(comm.Rhs) == 1 {
+				recv = comm.Rhs[0]
+			}
+		}
+		unary, ok := recv.(*ast.UnaryExpr)
+		if !ok || unary.Op != token.ARROW {
+			continue
+		}
+		if call, ok := unary.X.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Done" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasContextInScope returns true if one of the enclosing functions receives a context.Context
+func hasContextInScope(path []ast.Node, ctx *gosec.Context) bool {
+	for _, node := range path {
+		var params *ast.FieldList
+		switch fn := node.(type) {
+		case *ast.FuncLit:
+			params = fn.Type.Params
+		case *ast.FuncDecl:
+			params = fn.Type.Params
+		default:
+			continue
+		}
+		for _, field := range params.List {
+			if t := ctx.Info.TypeOf(field.Type); t != nil && types.TypeString(t, nil) == "context.Context" {
+				return true
+			}
+		}
+		if _, ok := node.(*ast.FuncDecl); ok {
+			return false
+		}
+	}
+	return false
+}
+
+// NewBlockingChannelOp detects channel sends and receives in functions receiving a
+// context, which block forever once the context is cancelled unless they are part
+// of a select handling ctx.Done().
+func NewBlockingChannelOp(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &blockingChannelOp{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Channel operation ignores the cancellation of the context, use a select on ctx.Done()",
+		},
+	}, []ast.Node{(*ast.SendStmt)(nil), (*ast.ExprStmt)(nil)}
+}
@@ -15,6 +15,7 @@
 package rules
 
 import (
+	"fmt"
 	"go/ast"
 	"strings"
 
@@ -92,3 +93,70 @@ func NewBlocklistedImportSHA1(id string, conf gosec.Config) (gosec.Rule, []ast.N
 		"crypto/sha1": "Blocklisted import crypto/sha1: weak cryptographic primitive",
 	})
 }
+
+type deprecatedIoutil struct {
+	*blocklistedImport
+	calls        gosec.CallList
+	replacements map[string]string
+	reportCalls  bool
+}
+
+func (r *deprecatedIoutil) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
+	if _, ok := n.(*ast.ImportSpec); ok {
+		return r.blocklistedImport.Match(n, c)
+	}
+	if !r.reportCalls {
+		return nil, nil
+	}
+	if call := r.calls.ContainsPkgCallExpr(n, c, false); call != nil {
+		_, name, err := gosec.GetCallInfo(call, c)
+		if err != nil {
+			return nil, nil
+		}
+		what := fmt.Sprintf("ioutil.%s is deprecated, use %s", name, r.replacements[name])
+		return gosec.NewIssue(c, call, r.ID(), what, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewBlocklistedImportIoutil fails if io/ioutil is imported, which is deprecated
+// since Go 1.16. Each call to the package can also be reported along with its
+// replacement:
+//
+//	{"G506": {"calls": true}}
+func NewBlocklistedImportIoutil(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	rule, _ := NewBlocklistedImports(id, conf, map[string]string{
+		"io/ioutil": "Blocklisted import io/ioutil: deprecated since Go 1.16, use the io and os packages",
+	})
+	imports := rule.(*blocklistedImport)
+	imports.Severity = gosec.Low
+
+	replacements := map[string]string{
+		"NopCloser": "io.NopCloser",
+		"ReadAll":   "io.ReadAll",
+		"ReadDir":   "os.ReadDir",
+		"ReadFile":  "os.ReadFile",
+		"TempDir":   "os.MkdirTemp",
+		"TempFile":  "os.CreateTemp",
+		"WriteFile": "os.WriteFile",
+	}
+	calls := gosec.NewCallList()
+	for name := range replacements {
+		calls.Add("io/ioutil", name)
+	}
+
+	reportCalls := false
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if enabled, ok := settings["calls"].(bool); ok {
+				reportCalls = enabled
+			}
+		}
+	}
+	return &deprecatedIoutil{
+		blocklistedImport: imports,
+		calls:             calls,
+		replacements:      replacements,
+		reportCalls:       reportCalls,
+	}, []ast.Node{(*ast.ImportSpec)(nil), (*ast.CallExpr)(nil)}
+}
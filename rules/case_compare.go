@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultSensitivePattern matches the role and permission names which should
+// not be compared after an ASCII case conversion
+const defaultSensitivePattern = `(?i)(admin|root|owner|superuser|operator|moderator|role|perm|privilege)`
+
+type caseConvertedComparison struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+}
+
+func (r *caseConvertedComparison) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *caseConvertedComparison) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.BinaryExpr:
+		if node.Op != token.EQL && node.Op != token.NEQ {
+			return nil, nil
+		}
+		if (r.isCaseConverted(node.X, ctx) && r.isSensitive(node.Y, ctx)) ||
+			(r.isCaseConverted(node.Y, ctx) && r.isSensitive(node.X, ctx)) {
+			return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.SwitchStmt:
+		if node.Tag == nil || !r.isCaseConverted(node.Tag, ctx) {
+			return nil, nil
+		}
+		for _, stmt := range node.Body.List {
+			clause, ok := stmt.(*ast.CaseClause)
+			if !ok {
+				continue
+			}
+			for _, expr := range clause.List {
+				if r.isSensitive(expr, ctx) {
+					return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// isCaseConverted returns true if the expression is a call to strings.ToLower
+// or strings.ToUpper
+func (r *caseConvertedComparison) isCaseConverted(expr ast.Expr, ctx *gosec.Context) bool {
+	_, matches := gosec.MatchCallByPackage(expr, ctx, "strings", "ToLower", "ToUpper")
+	return matches
+}
+
+// isSensitive returns true if the expression is a string constant whose value,
+// or name for a named constant, matches the sensitive pattern
+func (r *caseConvertedComparison) isSensitive(expr ast.Expr, ctx *gosec.Context) bool {
+	tv, ok := ctx.Info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return false
+	}
+	if r.pattern.MatchString(constant.StringVal(tv.Value)) {
+		return true
+	}
+	switch name := expr.(type) {
+	case *ast.Ident:
+		return r.pattern.MatchString(name.Name)
+	case *ast.SelectorExpr:
+		return r.pattern.MatchString(name.Sel.Name)
+	}
+	return false
+}
+
+// NewCaseConvertedComparison detects role or permission names compared after
+// converting the case with strings.ToLower or strings.ToUpper. The conversion
+// does not fold the Unicode case, e.g. "ADMİN" with a Turkish dotted İ does not
+// become "admin", which can be abused to bypass checks or to register a name
+// impersonating a privileged one. strings.EqualFold should be used instead. The
+// constants are matched by their value or name with a pattern which can be
+// configured:
+//
+//	{"G143": {"pattern": "(?i)^(admin|root)$"}}
+func NewCaseConvertedComparison(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultSensitivePattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultSensitivePattern)
+	}
+	return &caseConvertedComparison{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Sensitive name compared after strings.ToLower or strings.ToUpper, use strings.EqualFold instead",
+		},
+		pattern: re,
+	}, []ast.Node{(*ast.BinaryExpr)(nil), (*ast.SwitchStmt)(nil)}
+}
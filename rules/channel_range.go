@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unclosedChannelRange struct {
+	gosec.MetaData
+}
+
+func (r *unclosedChannelRange) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the range loops over a channel received as a parameter or held in
+// a field, when the package never closes such a channel and the loop does not
+// stop on the cancellation of a context.
+func (r *unclosedChannelRange) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok {
+		return nil, nil
+	}
+	typ := ctx.Info.TypeOf(rangeStmt.X)
+	if typ == nil {
+		return nil, nil
+	}
+	ch, ok := typ.Underlying().(*types.Chan)
+	if !ok {
+		return nil, nil
+	}
+
+	var field *types.Var
+	switch x := rangeStmt.X.(type) {
+	case *ast.Ident:
+		fn := gosec.GetEnclosingFuncDecl(rangeStmt, ctx)
+		if fn == nil || !declaresParam(fn, ctx.Info.ObjectOf(x), ctx) {
+			return nil, nil
+		}
+	case *ast.SelectorExpr:
+		selection, ok := ctx.Info.Selections[x]
+		if !ok || selection.Kind() != types.FieldVal {
+			return nil, nil
+		}
+		field, _ = selection.Obj().(*types.Var)
+	default:
+		return nil, nil
+	}
+
+	if stopsOnContext(rangeStmt.Body, ctx) || closesChannel(ch, field, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// declaresParam returns true if the object is one of the parameters of the function
+func declaresParam(fn *ast.FuncDecl, obj types.Object, ctx *gosec.Context) bool {
+	if obj == nil {
+		return false
+	}
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			if ctx.Info.Defs[name] == obj {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stopsOnContext returns true if the body checks the cancellation of a context
+// with its Done or Err method.
+func stopsOnContext(body *ast.BlockStmt, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok && (sel.Sel.Name == "Done" || sel.Sel.Name == "Err") {
+			if typ := ctx.Info.TypeOf(sel.X); typ != nil && types.TypeString(typ, nil) == "context.Context" {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// closesChannel returns true if the package closes the given field, or for the
+// channels received as parameters, any channel of the same element type.
+func closesChannel(ch *types.Chan, field *types.Var, ctx *gosec.Context) bool {
+	found := false
+	for _, file := range ctx.PkgFiles {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || found || len(call.Args) != 1 {
+				return !found
+			}
+			ident, ok := call.Fun.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			if _, ok := ctx.Info.ObjectOf(ident).(*types.Builtin); !ok || ident.Name != "close" {
+				return true
+			}
+			if field != nil {
+				if sel, ok := call.Args[0].(*ast.SelectorExpr); ok && ctx.Info.ObjectOf(sel.Sel) == field {
+					found = true
+				}
+				return !found
+			}
+			if typ := ctx.Info.TypeOf(call.Args[0]); typ != nil {
+				if closed, ok := typ.Underlying().(*types.Chan); ok && types.Identical(closed.Elem(), ch.Elem()) {
+					found = true
+				}
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// NewUnclosedChannelRange detects range loops over channels received as
+// parameters or held in fields, which the package never closes and which do
+// not stop when a context is cancelled. Such loops never end, blocking the
+// goroutine running them forever.
+func NewUnclosedChannelRange(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unclosedChannelRange{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Range over a channel which is never closed, the loop never ends",
+		},
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultSecretPattern matches the words of the names of the secrets, split on
+// the underscores and the camel case boundaries
+const defaultSecretPattern = `(?i)(^|_)(h?mac|token|sig(nature)?)s?($|_)`
+
+type secretComparison struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+}
+
+func (r *secretComparison) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *secretComparison) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CallExpr:
+		if _, matched := gosec.MatchCallByPackage(node, ctx, "bytes", "Equal"); matched && len(node.Args) == 2 {
+			if r.isSecret(node.Args[0], ctx) || r.isSecret(node.Args[1], ctx) {
+				return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	case *ast.BinaryExpr:
+		if node.Op != token.EQL && node.Op != token.NEQ {
+			return nil, nil
+		}
+		// Comparing against a literal or nil is a presence check, not a comparison of secrets.
+		if isConstant(node.X, ctx) || isConstant(node.Y, ctx) {
+			return nil, nil
+		}
+		if !isComparableBytes(ctx.Info.TypeOf(node.X)) {
+			return nil, nil
+		}
+		if r.isSecret(node.X, ctx) || r.isSecret(node.Y, ctx) {
+			return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isSecret returns true if the name of the operand looks like a secret
+func (r *secretComparison) isSecret(expr ast.Expr, ctx *gosec.Context) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return r.pattern.MatchString(gosec.SnakeCase(e.Name))
+	case *ast.SelectorExpr:
+		return r.pattern.MatchString(gosec.SnakeCase(e.Sel.Name))
+	case *ast.ParenExpr:
+		return r.isSecret(e.X, ctx)
+	case *ast.SliceExpr:
+		return r.isSecret(e.X, ctx)
+	case *ast.CallExpr:
+		// Conversions such as string(mac) or []byte(token)
+		if tv, ok := ctx.Info.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return r.isSecret(e.Args[0], ctx)
+		}
+	}
+	return false
+}
+
+func isConstant(expr ast.Expr, ctx *gosec.Context) bool {
+	tv, ok := ctx.Info.Types[expr]
+	return ok && (tv.Value != nil || tv.IsNil())
+}
+
+// isComparableBytes returns true for strings and byte arrays which can hold secrets
+func isComparableBytes(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch typ := t.Underlying().(type) {
+	case *types.Basic:
+		return typ.Info()&types.IsString != 0
+	case *types.Array:
+		elem, ok := typ.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	}
+	return false
+}
+
+// NewSecretComparison detects secrets such as MACs and tokens being compared with
+// bytes.Equal or ==, which leak through timing how many leading bytes matched.
+// The names are matched word by word, e.g. expectedMAC as expected_mac, with a
+// pattern which can be configured:
+//
+//	{"G405": {"pattern": "(^|_)(mac|token)($|_)"}}
+func NewSecretComparison(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultSecretPattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if cfgPattern, ok := settings["pattern"].(string); ok {
+				pattern = cfgPattern
+			}
+		}
+	}
+	return &secretComparison{
+		pattern: regexp.MustCompile(pattern),
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Secrets compared in non-constant time, use crypto/subtle.ConstantTimeCompare",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil), (*ast.BinaryExpr)(nil)}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type lostCancel struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *lostCancel) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *lostCancel) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	if r.calls.ContainsPkgCallExpr(assign.Rhs[0], ctx, false) == nil {
+		return nil, nil
+	}
+	cancel, ok := assign.Lhs[1].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	if cancel.Name == "_" {
+		return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+
+	// the cancel function is handled if it is called, deferred, returned or
+	// passed along after the assignment, but lost if it is overwritten first
+	fn := gosec.GetEnclosingFuncDecl(assign, ctx)
+	obj := ctx.Info.ObjectOf(cancel)
+	if fn == nil || fn.Body == nil || obj == nil || isResult(obj, fn, ctx) {
+		return nil, nil
+	}
+	if next := nextUse(fn.Body, obj, assign, ctx); next == nil || isOverwritten(fn.Body, next) {
+		return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isResult returns true for the named results, which are returned to the caller
+func isResult(obj types.Object, fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, field := range fn.Type.Results.List {
+		for _, name := range field.Names {
+			if ctx.Info.Defs[name] == obj {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nextUse returns the first identifier referring to the variable after the node
+func nextUse(body *ast.BlockStmt, obj types.Object, after ast.Node, ctx *gosec.Context) *ast.Ident {
+	var next *ast.Ident
+	ast.Inspect(body, func(node ast.Node) bool {
+		ident, ok := node.(*ast.Ident)
+		if ok && ident.Pos() > after.End() && ctx.Info.Uses[ident] == obj && (next == nil || ident.Pos() < next.Pos()) {
+			next = ident
+		}
+		return true
+	})
+	return next
+}
+
+// isOverwritten returns true if the identifier is assigned a new value
+func isOverwritten(body *ast.BlockStmt, ident *ast.Ident) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if assign, ok := node.(*ast.AssignStmt); ok && assign.Tok == token.ASSIGN {
+			for _, lhs := range assign.Lhs {
+				if lhs == ident {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewLostCancel detects the cancel functions returned by context.WithCancel,
+// WithTimeout or WithDeadline which are discarded, or overwritten before being
+// called, leaking the context and its timer until its parent is cancelled.
+func NewLostCancel(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("context", "WithCancel", "WithCancelCause", "WithTimeout", "WithTimeoutCause", "WithDeadline", "WithDeadlineCause")
+	return &lostCancel{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Cancel function of the context is not called, defer it to release the context",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type ignoredContext struct {
+	gosec.MetaData
+}
+
+func (r *ignoredContext) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the functions discarding their context.Context parameter, naming
+// it _ or leaving it unnamed, while passing a new context.Background or
+// context.TODO to the functions they call.
+func (r *ignoredContext) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var funcType *ast.FuncType
+	var body *ast.BlockStmt
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		funcType, body = fn.Type, fn.Body
+	case *ast.FuncLit:
+		funcType, body = fn.Type, fn.Body
+	default:
+		return nil, nil
+	}
+	if body == nil || funcType.Params == nil || !discardsContext(funcType.Params, ctx) {
+		return nil, nil
+	}
+	var fresh *ast.CallExpr
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || fresh != nil {
+			return fresh == nil
+		}
+		for _, arg := range call.Args {
+			if background, matches := gosec.MatchCallByPackage(arg, ctx, "context", "Background", "TODO"); matches {
+				fresh = background
+				break
+			}
+		}
+		return fresh == nil
+	})
+	if fresh == nil {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, fresh, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// discardsContext returns true if a context.Context parameter is named _ or unnamed
+func discardsContext(params *ast.FieldList, ctx *gosec.Context) bool {
+	for _, field := range params.List {
+		t := ctx.Info.TypeOf(field.Type)
+		if t == nil || types.TypeString(t, nil) != "context.Context" {
+			continue
+		}
+		if len(field.Names) == 0 {
+			return true
+		}
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewIgnoredContext detects functions which discard the context.Context they
+// receive and pass context.Background or context.TODO to the functions they
+// call instead. The cancellation and deadline of the caller are then lost, and
+// the received context should be passed on.
+func NewIgnoredContext(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &ignoredContext{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Received context ignored and replaced by a new one, pass the received context on",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type contextKeyType struct {
+	gosec.MetaData
+}
+
+func (r *contextKeyType) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *contextKeyType) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	node, matched := gosec.MatchCallByPackage(n, ctx, "context", "WithValue")
+	if !matched || len(node.Args) < 2 {
+		return nil, nil
+	}
+	// Keys of a named type such as "type ctxKey struct{}" cannot collide with
+	// the keys of other packages, while builtin types and constants can.
+	if _, ok := ctx.Info.TypeOf(node.Args[1]).(*types.Basic); ok {
+		return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewContextKeyType detects context values stored under keys of builtin types
+// which may collide with the keys used by other packages.
+func NewContextKeyType(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &contextKeyType{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Context value stored with a key of a builtin type, use an unexported key type",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type contextNotFirst struct {
+	gosec.MetaData
+	allowedBefore map[string]bool
+}
+
+func (r *contextNotFirst) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *contextNotFirst) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	funcType, ok := n.(*ast.FuncType)
+	if !ok || funcType.Params == nil {
+		return nil, nil
+	}
+	first := true
+	for _, field := range funcType.Params.List {
+		t := ctx.Info.TypeOf(field.Type)
+		if t == nil {
+			return nil, nil
+		}
+		name := types.TypeString(t, nil)
+		if name == "context.Context" {
+			if first {
+				return nil, nil
+			}
+			return gosec.NewIssue(ctx, field, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+		// helpers such as func(t *testing.T, ctx context.Context)
+		if !r.allowedBefore[name] {
+			first = false
+		}
+	}
+	return nil, nil
+}
+
+// NewContextNotFirst detects functions taking a context.Context which is not their
+// first parameter, as expected by the Go conventions and tools. The types allowed
+// before the context default to the testing types and can be configured:
+//
+//	{"G134": {"allowed_before": ["*testing.T", "*testing.B"]}}
+func NewContextNotFirst(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	allowedBefore := map[string]bool{"*testing.T": true, "*testing.B": true, "*testing.F": true, "testing.TB": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["allowed_before"].([]interface{}); ok {
+				allowedBefore = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						allowedBefore[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &contextNotFirst{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "context.Context should be the first parameter of the function",
+		},
+		allowedBefore: allowedBefore,
+	}, []ast.Node{(*ast.FuncType)(nil)}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type ignoredCopyLength struct {
+	gosec.MetaData
+}
+
+func (r *ignoredCopyLength) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *ignoredCopyLength) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return nil, nil
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	if builtin, ok := ctx.Info.Uses[fun].(*types.Builtin); !ok || builtin.Name() != "copy" {
+		return nil, nil
+	}
+	dst, src := call.Args[0], call.Args[1]
+	if !isSlice(dst, ctx) || !isSlice(src, ctx) || allocatedWithLenOf(dst, src, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+func isSlice(expr ast.Expr, ctx *gosec.Context) bool {
+	_, ok := typeUnderlying(expr, ctx).(*types.Slice)
+	return ok
+}
+
+// allocatedWithLenOf returns true if dst was declared as make([]T, len(src)),
+// in which case both slices are known to have the same length.
+func allocatedWithLenOf(dst, src ast.Expr, ctx *gosec.Context) bool {
+	ident, ok := dst.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	fn := gosec.GetEnclosingFuncDecl(dst, ctx)
+	if obj == nil || fn == nil || fn.Body == nil {
+		return false
+	}
+
+	found := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) || assign.Pos() > dst.Pos() {
+			return !found
+		}
+		for i, lhs := range assign.Lhs {
+			if lhsIdent, ok := lhs.(*ast.Ident); ok && ctx.Info.ObjectOf(lhsIdent) == obj {
+				found = isMakeWithLenOf(assign.Rhs[i], src, ctx)
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isMakeWithLenOf returns true for make([]T, len(src)) and make([]T, len(src), c)
+func isMakeWithLenOf(expr, src ast.Expr, ctx *gosec.Context) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return false
+	}
+	if fun, ok := call.Fun.(*ast.Ident); !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("make") {
+		return false
+	}
+	size, ok := call.Args[1].(*ast.CallExpr)
+	if !ok || len(size.Args) != 1 {
+		return false
+	}
+	if fun, ok := size.Fun.(*ast.Ident); !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("len") {
+		return false
+	}
+	return types.ExprString(size.Args[0]) == types.ExprString(src)
+}
+
+// NewIgnoredCopyLength detects calls to copy whose number of copied elements is
+// ignored although the source and destination slices may differ in length.
+func NewIgnoredCopyLength(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &ignoredCopyLength{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "The number of elements copied is ignored, copy stops at the shorter of both slices",
+		},
+	}, []ast.Node{(*ast.ExprStmt)(nil)}
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type deferredReceiver struct {
+	gosec.MetaData
+}
+
+func (r *deferredReceiver) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *deferredReceiver) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
+	deferStmt, ok := n.(*ast.DeferStmt)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := deferStmt.Call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, nil
+	}
+	recv, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj, ok := c.Info.ObjectOf(recv).(*types.Var)
+	if !ok {
+		return nil, nil
+	}
+	fn := gosec.GetEnclosingFuncDecl(deferStmt, c)
+	if fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+
+	// The receiver is evaluated when the defer statement is executed, so any
+	// later assignment leaves the deferred call running on the previous value.
+	reassigned := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.ASSIGN || assign.Pos() < deferStmt.End() {
+			return !reassigned
+		}
+		for _, lhs := range assign.Lhs {
+			if ident, ok := lhs.(*ast.Ident); ok && c.Info.ObjectOf(ident) == obj {
+				reassigned = true
+			}
+		}
+		return !reassigned
+	})
+	if !reassigned {
+		return nil, nil
+	}
+	what := fmt.Sprintf(r.What, sel.Sel.Name, recv.Name)
+	return gosec.NewIssue(c, n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewDeferredReceiverReassigned detects deferred method calls whose receiver
+// variable is assigned a new value before the function returns.
+func NewDeferredReceiverReassigned(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &deferredReceiver{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Deferred %s runs on the previous value of %s which is reassigned afterwards",
+		},
+	}, []ast.Node{(*ast.DeferStmt)(nil)}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type deferredErrorDropped struct {
+	gosec.MetaData
+	methods map[string]bool
+}
+
+func (r *deferredErrorDropped) ID() string {
+	return r.MetaData.ID
+}
+
+// persistingMethods lose data when their error is dropped and are reported with
+// a higher severity
+var persistingMethods = map[string]bool{"Commit": true, "Sync": true}
+
+func (r *deferredErrorDropped) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	deferStmt, ok := n.(*ast.DeferStmt)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := deferStmt.Call.Fun.(*ast.SelectorExpr)
+	if !ok || !r.methods[sel.Sel.Name] {
+		return nil, nil
+	}
+	selection, ok := ctx.Info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil, nil
+	}
+	sig, ok := selection.Type().(*types.Signature)
+	if !ok || sig.Results().Len() == 0 {
+		return nil, nil
+	}
+	if last := sig.Results().At(sig.Results().Len() - 1).Type(); !types.Identical(last, types.Universe.Lookup("error").Type()) {
+		return nil, nil
+	}
+
+	// the deferred os.File.Close is already reported by G307
+	recv := types.TypeString(selection.Recv(), nil)
+	if normalize(recv) == "os.File" && sel.Sel.Name == "Close" {
+		return nil, nil
+	}
+	severity := r.Severity
+	if persistingMethods[sel.Sel.Name] {
+		severity = gosec.Medium
+	}
+	what := fmt.Sprintf(r.What, sel.Sel.Name)
+	return gosec.NewIssue(ctx, deferStmt, r.ID(), what, severity, r.Confidence), nil
+}
+
+// NewDeferredErrorDropped detects deferred calls to methods such as Close, Flush
+// or Commit whose error is dropped, losing the write errors of the data being
+// persisted. The error should be returned through a named result. The methods
+// can be configured:
+//
+//	{"G308": {"methods": ["Close", "Flush", "Commit", "Sync"]}}
+func NewDeferredErrorDropped(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	methods := map[string]bool{"Close": true, "Flush": true, "Commit": true, "Sync": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &deferredErrorDropped{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Error of the deferred %s call is dropped, return it through a named result",
+		},
+		methods: methods,
+	}, []ast.Node{(*ast.DeferStmt)(nil)}
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type errorProneCall struct {
+	gosec.MetaData
+	calls       gosec.CallList
+	suggestions map[string]string
+}
+
+func (r *errorProneCall) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *errorProneCall) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, ctx, true)
+	if call == nil {
+		return nil, nil
+	}
+	selector, ident, err := gosec.GetCallInfo(call, ctx)
+	if err != nil {
+		return nil, nil
+	}
+	path, _ := gosec.GetImportPath(selector, ctx)
+	if vendorIdx := strings.Index(path, "vendor/"); vendorIdx >= 0 {
+		path = path[vendorIdx+len("vendor/"):]
+	}
+	name := path + "." + ident
+
+	// strings.Replace is only equivalent to strings.ReplaceAll with a count of -1
+	if name == "strings.Replace" && (len(call.Args) != 4 || !isMinusOne(call.Args[3], ctx)) {
+		return nil, nil
+	}
+	what := fmt.Sprintf(r.What, name, r.suggestions[name])
+	return gosec.NewIssue(ctx, call, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+func isMinusOne(expr ast.Expr, ctx *gosec.Context) bool {
+	tv, ok := ctx.Info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return false
+	}
+	val, exact := constant.Int64Val(tv.Value)
+	return exact && val == -1
+}
+
+// NewErrorProneCall detects calls to deprecated or error prone functions of the
+// standard library and suggests their replacement. Additional functions can be
+// configured by their import path and name, for example:
+//
+//	{"G118": {"io/ioutil.ReadAll": "use io.ReadAll"}}
+func NewErrorProneCall(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	suggestions := map[string]string{
+		"strings.Replace": "use strings.ReplaceAll",
+		"strings.Title":   "use golang.org/x/text/cases, strings.Title does not handle Unicode punctuation",
+	}
+	if val, ok := conf[id]; ok {
+		if configured, ok := val.(map[string]interface{}); ok {
+			for name, suggestion := range configured {
+				if suggestion, ok := suggestion.(string); ok && !gosec.IsPathSetting(name) {
+					suggestions[name] = suggestion
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(suggestions))
+	for name := range suggestions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	calls := gosec.NewCallList()
+	for _, name := range names {
+		if idx := strings.LastIndex(name, "."); idx > 0 {
+			calls.Add(name[:idx], name[idx+1:])
+		}
+	}
+
+	return &errorProneCall{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Call to %s, %s",
+		},
+		calls:       calls,
+		suggestions: suggestions,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type discardedResult struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *discardedResult) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *discardedResult) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	if r.calls.ContainsPkgCallExpr(call, ctx, false) == nil {
+		return nil, nil
+	}
+	what := fmt.Sprintf(r.What, types.ExprString(call.Fun))
+	return gosec.NewIssue(ctx, call, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewDiscardedResult detects calls to functions without side effects whose result
+// is discarded, e.g. strings.TrimSpace(s) used as a statement. The builtins such
+// as append are left to the compiler, which rejects them as statements. Further
+// functions can be configured per package:
+//
+//	{"G125": {"path": ["Clean", "Join"]}}
+func NewDiscardedResult(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	transforms := []string{
+		"Trim", "TrimFunc", "TrimLeft", "TrimLeftFunc", "TrimPrefix", "TrimRight", "TrimRightFunc",
+		"TrimSpace", "TrimSuffix", "Replace", "ReplaceAll", "ToLower", "ToUpper", "ToTitle",
+	}
+	calls.AddAll("strings", transforms...)
+	calls.AddAll("bytes", transforms...)
+
+	if configured, ok := conf[id]; ok {
+		if functions, ok := configured.(map[string]interface{}); ok {
+			pkgs := make([]string, 0, len(functions))
+			for pkg := range functions {
+				if !gosec.IsPathSetting(pkg) {
+					pkgs = append(pkgs, pkg)
+				}
+			}
+			sort.Strings(pkgs)
+			for _, pkg := range pkgs {
+				funcs, ok := functions[pkg].([]interface{})
+				if !ok {
+					continue
+				}
+				calls.AddAll(pkg, toStringSlice(funcs)...)
+			}
+		}
+	}
+
+	return &discardedResult{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Result of %s is discarded although the call has no side effect",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.ExprStmt)(nil)}
+}
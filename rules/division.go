@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type uncheckedDivisor struct {
+	gosec.MetaData
+}
+
+func (r *uncheckedDivisor) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *uncheckedDivisor) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var divisor ast.Expr
+	switch expr := n.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op != token.QUO && expr.Op != token.REM {
+			return nil, nil
+		}
+		divisor = expr.Y
+	case *ast.AssignStmt:
+		if (expr.Tok != token.QUO_ASSIGN && expr.Tok != token.REM_ASSIGN) || len(expr.Rhs) != 1 {
+			return nil, nil
+		}
+		divisor = expr.Rhs[0]
+	default:
+		return nil, nil
+	}
+
+	// only the integer divisions panic, the floating point ones return infinity
+	ident, ok := divisor.(*ast.Ident)
+	if !ok || isConstant(ident, ctx) {
+		return nil, nil
+	}
+	typ := ctx.Info.TypeOf(ident)
+	if typ == nil {
+		return nil, nil
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	if obj == nil {
+		return nil, nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, n.Pos(), n.End())
+	for len(path) > 0 && path[0] != n {
+		path = path[1:]
+	}
+	checked := isDominatedBy(path, func(cond ast.Expr) bool {
+		return comparesWithConstant(cond, obj, ctx)
+	})
+	if checked {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// comparesWithConstant returns true if the condition compares the variable with
+// a constant, e.g. d != 0 or d > 0.
+func comparesWithConstant(cond ast.Expr, obj types.Object, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(cond, func(node ast.Node) bool {
+		binary, ok := node.(*ast.BinaryExpr)
+		if !ok {
+			return !found
+		}
+		switch binary.Op {
+		case token.LSS, token.LEQ, token.GTR, token.GEQ, token.EQL, token.NEQ:
+			objs := map[types.Object]bool{obj: true}
+			found = (usesAny(binary.X, objs, ctx) && isConstant(binary.Y, ctx)) ||
+				(usesAny(binary.Y, objs, ctx) && isConstant(binary.X, ctx))
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUncheckedDivisor detects integer divisions and modulos by a variable which
+// is not checked against zero beforehand, which panic at runtime and may halt
+// the nodes of a chain.
+func NewUncheckedDivisor(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &uncheckedDivisor{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Integer division by a variable which is not checked against zero and may panic",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil), (*ast.AssignStmt)(nil)}
+}
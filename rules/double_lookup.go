@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type doubleLookup struct {
+	gosec.MetaData
+}
+
+func (r *doubleLookup) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the membership checks discarding the value, _, ok := m[k], which
+// are followed by an indexing of the same map with the same key, either in the
+// body of the if statement they initialize or in the rest of their block.
+func (r *doubleLookup) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.IfStmt:
+		if lookup := membershipCheck(node.Init, ctx); lookup != nil && reindexes(node.Body, lookup, ctx) {
+			return gosec.NewIssue(ctx, node.Init, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.BlockStmt:
+		for i, stmt := range node.List {
+			lookup := membershipCheck(stmt, ctx)
+			if lookup == nil {
+				continue
+			}
+			for _, next := range node.List[i+1:] {
+				if reindexes(next, lookup, ctx) {
+					return gosec.NewIssue(ctx, stmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// membershipCheck returns the index expression of a _, ok := m[k] statement
+// whose map and key are variables or constants.
+func membershipCheck(stmt ast.Stmt, ctx *gosec.Context) *ast.IndexExpr {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	if blank, ok := assign.Lhs[0].(*ast.Ident); !ok || blank.Name != "_" {
+		return nil
+	}
+	index, ok := assign.Rhs[0].(*ast.IndexExpr)
+	if !ok {
+		return nil
+	}
+	typ := ctx.Info.TypeOf(index.X)
+	if typ == nil {
+		return nil
+	}
+	if _, ok := typ.Underlying().(*types.Map); !ok {
+		return nil
+	}
+	if _, ok := index.X.(*ast.Ident); !ok {
+		return nil
+	}
+	if _, ok := index.Index.(*ast.Ident); !ok && !isConstant(index.Index, ctx) {
+		return nil
+	}
+	return index
+}
+
+// reindexes returns true if the node reads the same map with the same key as
+// the lookup. Writing the map with this key is not a redundant lookup.
+func reindexes(node ast.Node, lookup *ast.IndexExpr, ctx *gosec.Context) bool {
+	written := make(map[ast.Expr]bool)
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				written[lhs] = true
+			}
+		}
+		if index, ok := n.(*ast.IndexExpr); ok && !written[index] && sameOperand(index.X, lookup.X, ctx) && sameOperand(index.Index, lookup.Index, ctx) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// sameOperand returns true if both expressions are the same variable or equal constants
+func sameOperand(a, b ast.Expr, ctx *gosec.Context) bool {
+	if tva, ok := ctx.Info.Types[a]; ok && tva.Value != nil {
+		tvb, ok := ctx.Info.Types[b]
+		return ok && tvb.Value != nil && constant.Compare(tva.Value, token.EQL, tvb.Value)
+	}
+	identA, ok := a.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	identB, ok := b.(*ast.Ident)
+	return ok && ctx.Info.ObjectOf(identA) != nil && ctx.Info.ObjectOf(identA) == ctx.Info.ObjectOf(identB)
+}
+
+// NewDoubleLookup detects membership checks discarding the value of the map,
+// _, ok := m[k], followed by m[k]. The map is looked up twice where v, ok := m[k]
+// looks it up once.
+func NewDoubleLookup(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &doubleLookup{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Map looked up twice with the same key, use v, ok := m[k] instead",
+		},
+	}, []ast.Node{(*ast.IfStmt)(nil), (*ast.BlockStmt)(nil)}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type errOverwrite struct {
+	gosec.MetaData
+}
+
+func (r *errOverwrite) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *errOverwrite) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var stmts []ast.Stmt
+	switch block := n.(type) {
+	case *ast.BlockStmt:
+		stmts = block.List
+	case *ast.CaseClause:
+		stmts = block.Body
+	case *ast.CommClause:
+		stmts = block.Body
+	default:
+		return nil, nil
+	}
+	for i, stmt := range stmts {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok {
+			continue
+		}
+		for _, obj := range assignedErrors(assign, ctx) {
+			if isOverwrittenBeforeUse(stmts[i+1:], obj, ctx) {
+				return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// assignedErrors returns the local error variables assigned the result of a call
+func assignedErrors(assign *ast.AssignStmt, ctx *gosec.Context) []types.Object {
+	if len(assign.Rhs) == 0 {
+		return nil
+	}
+	if _, ok := assign.Rhs[len(assign.Rhs)-1].(*ast.CallExpr); !ok {
+		return nil
+	}
+	var objs []types.Object
+	for _, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+		obj, ok := ctx.Info.ObjectOf(ident).(*types.Var)
+		if !ok || obj.Parent() == nil || obj.Parent() == ctx.Pkg.Scope() || !types.Identical(obj.Type(), types.Universe.Lookup("error").Type()) {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+// isOverwrittenBeforeUse returns true if the first of the statements referencing
+// the error assigns it a new value without reading it.
+func isOverwrittenBeforeUse(stmts []ast.Stmt, obj types.Object, ctx *gosec.Context) bool {
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			reads := false
+			for _, rhs := range assign.Rhs {
+				reads = reads || refersTo(ctx, rhs, obj)
+			}
+			for _, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && !reads && ctx.Info.ObjectOf(ident) == obj {
+					return true
+				}
+			}
+		}
+		referenced := false
+		ast.Inspect(stmt, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+				referenced = true
+			}
+			return !referenced
+		})
+		if referenced {
+			return false
+		}
+	}
+	return false
+}
+
+// NewErrOverwrite detects errors which are overwritten by the next operation
+// before being checked, e.g. err = f(); err = g(), losing the error of f. Each
+// error should be handled after its call, or combined with errors.Join.
+func NewErrOverwrite(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &errOverwrite{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Error overwritten before being checked, handle it or combine the errors with errors.Join",
+		},
+	}, []ast.Node{(*ast.BlockStmt)(nil), (*ast.CaseClause)(nil), (*ast.CommClause)(nil)}
+}
@@ -0,0 +1,166 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type errShadowing struct {
+	gosec.MetaData
+}
+
+func (r *errShadowing) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *errShadowing) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE {
+		return nil, nil
+	}
+	for _, expr := range assign.Lhs {
+		ident, ok := expr.(*ast.Ident)
+		if !ok || ident.Name != "err" {
+			continue
+		}
+		// There is no definition when err is reused within the same scope.
+		inner := ctx.Info.Defs[ident]
+		if inner == nil || inner.Parent() == nil || inner.Parent().Parent() == nil {
+			continue
+		}
+		scope := inner.Parent()
+		_, outer := scope.Parent().LookupParent(ident.Name, ident.Pos())
+		if _, ok := outer.(*types.Var); !ok || outer.Parent() == ctx.Pkg.Scope() {
+			continue
+		}
+		fn := gosec.GetEnclosingFuncDecl(assign, ctx)
+		if fn == nil || fn.Body == nil {
+			continue
+		}
+		if returnsAtEnd(ctx, fn.Body, scope, inner) {
+			continue
+		}
+		if readAfter(ctx, fn.Body, outer, scope.End()) {
+			return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// returnsAtEnd returns true if the last statement of the scope returns the
+// shadowing variable, which is then checked by the caller.
+func returnsAtEnd(ctx *gosec.Context, body *ast.BlockStmt, scope *types.Scope, obj types.Object) bool {
+	var stmts []ast.Stmt
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || stmts != nil || n.End() < scope.Pos() || n.Pos() > scope.End() {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.BlockStmt:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.List
+			}
+		case *ast.IfStmt:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.Body.List
+			}
+		case *ast.ForStmt:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.Body.List
+			}
+		case *ast.RangeStmt:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.Body.List
+			}
+		case *ast.CaseClause:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.Body
+			}
+		case *ast.CommClause:
+			if ctx.Info.Scopes[node] == scope {
+				stmts = node.Body
+			}
+		case *ast.FuncLit:
+			if ctx.Info.Scopes[node.Type] == scope {
+				stmts = node.Body.List
+			}
+		}
+		return stmts == nil
+	})
+	if len(stmts) == 0 {
+		return false
+	}
+	ret, ok := stmts[len(stmts)-1].(*ast.ReturnStmt)
+	if !ok {
+		return false
+	}
+	for _, result := range ret.Results {
+		if refersTo(ctx, result, obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// readAfter returns true if the first reference to obj in the function body
+// after pos reads its value instead of overwriting it with a new one.
+func readAfter(ctx *gosec.Context, body *ast.BlockStmt, obj types.Object, pos token.Pos) bool {
+	read, done := false, false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || done || n.End() <= pos {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if node.Tok != token.ASSIGN || node.Pos() <= pos {
+				return true
+			}
+			for _, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+					for _, rhs := range node.Rhs {
+						if refersTo(ctx, rhs, obj) {
+							read, done = true, true
+							return false
+						}
+					}
+					done = true
+					return false
+				}
+			}
+		case *ast.Ident:
+			if node.Pos() > pos && ctx.Info.ObjectOf(node) == obj {
+				read, done = true, true
+			}
+		}
+		return !done
+	})
+	return read
+}
+
+// refersTo returns true if the expression references the given object
+func refersTo(ctx *gosec.Context, expr ast.Expr, obj types.Object) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewErrShadowing detects when an inner scope re-declares an err variable which
+// is still used by the outer scope, hence the inner error is never checked.
+func NewErrShadowing(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &errShadowing{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Declaration of err shadows an outer err variable which is used afterwards",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
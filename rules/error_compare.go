@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type sentinelComparison struct {
+	gosec.MetaData
+}
+
+func (r *sentinelComparison) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *sentinelComparison) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	expr, ok := n.(*ast.BinaryExpr)
+	if !ok || (expr.Op != token.EQL && expr.Op != token.NEQ) {
+		return nil, nil
+	}
+	if !(isErrorValue(expr.X, ctx) && isSentinel(expr.Y, ctx)) && !(isErrorValue(expr.Y, ctx) && isSentinel(expr.X, ctx)) {
+		return nil, nil
+	}
+	// the Is methods used by errors.Is compare their target directly
+	if fn := gosec.GetEnclosingFuncDecl(expr, ctx); fn != nil && fn.Recv != nil && fn.Name.Name == "Is" {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, expr, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+func isErrorValue(expr ast.Expr, ctx *gosec.Context) bool {
+	t := ctx.Info.TypeOf(expr)
+	return t != nil && types.Implements(t, errorType)
+}
+
+// isSentinel returns true for the package level variables holding an error,
+// except io.EOF which the readers are required to return unwrapped.
+func isSentinel(expr ast.Expr, ctx *gosec.Context) bool {
+	var ident *ast.Ident
+	switch e := expr.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return false
+	}
+	obj, ok := ctx.Info.ObjectOf(ident).(*types.Var)
+	if !ok || obj.Pkg() == nil || obj.Parent() != obj.Pkg().Scope() {
+		return false
+	}
+	if obj.Pkg().Path() == "io" && obj.Name() == "EOF" {
+		return false
+	}
+	return types.Implements(obj.Type(), errorType)
+}
+
+// NewSentinelComparison detects errors compared with a sentinel error using == or
+// !=, which fails as soon as the error is wrapped. errors.Is should be used instead.
+func NewSentinelComparison(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &sentinelComparison{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Error compared with a sentinel error, use errors.Is to handle wrapped errors",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil)}
+}
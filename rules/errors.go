@@ -93,7 +93,9 @@ func NewNoErrorCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 		if whitelisted, ok := configured.(map[string]interface{}); ok {
 			pkgs := make([]string, 0, len(whitelisted))
 			for pkg := range whitelisted {
-				pkgs = append(pkgs, pkg)
+				if !gosec.IsPathSetting(pkg) {
+					pkgs = append(pkgs, pkg)
+				}
 			}
 			sort.Slice(pkgs, func(i, j int) bool { return pkgs[i] < pkgs[j] })
 			for _, pkg := range pkgs {
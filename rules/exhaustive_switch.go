@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// enumMarker marks in its doc comment a type whose switches must be exhaustive
+const enumMarker = "gosec:enum"
+
+type exhaustiveSwitch struct {
+	gosec.MetaData
+	enums map[string]bool
+}
+
+func (r *exhaustiveSwitch) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *exhaustiveSwitch) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var missing []string
+	switch stmt := n.(type) {
+	case *ast.SwitchStmt:
+		if stmt.Tag == nil || hasDefault(stmt.Body) {
+			return nil, nil
+		}
+		named, ok := ctx.Info.TypeOf(stmt.Tag).(*types.Named)
+		if !ok || !r.isEnum(named, ctx) {
+			return nil, nil
+		}
+		missing = missingConstants(named, stmt.Body, ctx)
+	case *ast.TypeSwitchStmt:
+		if hasDefault(stmt.Body) {
+			return nil, nil
+		}
+		named, ok := ctx.Info.TypeOf(typeSwitched(stmt)).(*types.Named)
+		if !ok || !r.isEnum(named, ctx) {
+			return nil, nil
+		}
+		missing = missingImplementations(named, stmt.Body, ctx)
+	default:
+		return nil, nil
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+	what := fmt.Sprintf(r.What, strings.Join(missing, ", "))
+	return gosec.NewIssue(ctx, n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// isEnum returns true if the type is configured as an enum or if its declaration,
+// which must then be in the analyzed package, is marked as one.
+func (r *exhaustiveSwitch) isEnum(named *types.Named, ctx *gosec.Context) bool {
+	if r.enums[types.TypeString(named, nil)] {
+		return true
+	}
+	for _, file := range ctx.PkgFiles {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || ctx.Info.Defs[typeSpec.Name] != named.Obj() {
+					continue
+				}
+				return hasMarker(genDecl.Doc) || hasMarker(typeSpec.Doc) || hasMarker(typeSpec.Comment)
+			}
+		}
+	}
+	return false
+}
+
+func hasMarker(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.Contains(comment.Text, enumMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDefault(body *ast.BlockStmt) bool {
+	for _, stmt := range body.List {
+		if clause, ok := stmt.(*ast.CaseClause); ok && clause.List == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// typeSwitched returns the expression x of the x.(type) guard
+func typeSwitched(stmt *ast.TypeSwitchStmt) ast.Expr {
+	var guard ast.Expr
+	switch assign := stmt.Assign.(type) {
+	case *ast.AssignStmt:
+		if len(assign.Rhs) == 1 {
+			guard = assign.Rhs[0]
+		}
+	case *ast.ExprStmt:
+		guard = assign.X
+	}
+	if assertion, ok := guard.(*ast.TypeAssertExpr); ok {
+		return assertion.X
+	}
+	return nil
+}
+
+// missingConstants returns the constants of the enum type, declared in its
+// package, whose values are not handled by any case.
+func missingConstants(named *types.Named, body *ast.BlockStmt, ctx *gosec.Context) []string {
+	handled := make(map[string]bool)
+	for _, stmt := range body.List {
+		if clause, ok := stmt.(*ast.CaseClause); ok {
+			for _, expr := range clause.List {
+				if tv, ok := ctx.Info.Types[expr]; ok && tv.Value != nil {
+					handled[tv.Value.ExactString()] = true
+				}
+			}
+		}
+	}
+
+	var missing []string
+	scope := named.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		constant, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(constant.Type(), named) {
+			continue
+		}
+		if !handled[constant.Val().ExactString()] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// missingImplementations returns the concrete types of the package of the enum
+// interface which implement it and are not handled by any case.
+func missingImplementations(named *types.Named, body *ast.BlockStmt, ctx *gosec.Context) []string {
+	iface, ok := named.Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+	var handled []types.Type
+	for _, stmt := range body.List {
+		if clause, ok := stmt.(*ast.CaseClause); ok {
+			for _, expr := range clause.List {
+				if t := ctx.Info.TypeOf(expr); t != nil {
+					handled = append(handled, t)
+				}
+			}
+		}
+	}
+
+	var missing []string
+	scope := named.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || types.IsInterface(typeName.Type()) {
+			continue
+		}
+		member := typeName.Type()
+		if !types.Implements(member, iface) && !types.Implements(types.NewPointer(member), iface) {
+			continue
+		}
+		found := false
+		for _, t := range handled {
+			if types.Identical(t, member) || types.Identical(t, types.NewPointer(member)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// NewExhaustiveSwitch detects switches over consensus enums which neither handle
+// every member nor have a default case, silently skipping the logic of the
+// members added later. The enums are the constants of a named type, or the types
+// of its package implementing a named interface in type switches, and are marked
+// by a "gosec:enum" comment on their declaration or configured by name:
+//
+//	{"G136": {"enums": ["github.com/cosmos/cosmos-sdk/x/staking/types.BondStatus"]}}
+func NewExhaustiveSwitch(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	enums := make(map[string]bool)
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["enums"].([]interface{}); ok {
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						enums[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &exhaustiveSwitch{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Switch over an enum without a default case does not handle %s",
+		},
+		enums: enums,
+	}, []ast.Node{(*ast.SwitchStmt)(nil), (*ast.TypeSwitchStmt)(nil)}
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type exitInLibrary struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *exitInLibrary) ID() string {
+	return r.MetaData.ID
+}
+
+// isEntryPoint returns true if the function is allowed to terminate the process
+func isEntryPoint(fn *ast.FuncDecl) bool {
+	if fn == nil || fn.Recv != nil {
+		return false
+	}
+	switch fn.Name.Name {
+	case "main", "init", "TestMain":
+		return true
+	}
+	return false
+}
+
+func (r *exitInLibrary) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	if ctx.Pkg.Name() == "main" {
+		return nil, nil
+	}
+	if call := r.calls.ContainsPkgCallExpr(n, ctx, false); call != nil {
+		if isEntryPoint(gosec.GetEnclosingFuncDecl(call, ctx)) {
+			return nil, nil
+		}
+		return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewExitInLibrary detects library code terminating the process, which bypasses
+// deferred cleanups and prevents callers from shutting down gracefully.
+func NewExitInLibrary(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("os", "Exit")
+	calls.AddAll("log", "Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln")
+	return &exitInLibrary{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Library code terminates the process instead of returning an error",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
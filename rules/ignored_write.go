@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type ignoredWrite struct {
+	gosec.MetaData
+	writer  *types.Interface
+	allowed map[string]bool
+}
+
+func (r *ignoredWrite) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *ignoredWrite) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Write" && sel.Sel.Name != "WriteString") {
+		return nil, nil
+	}
+	selection, ok := ctx.Info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil, nil
+	}
+	typ := selection.Recv()
+	if !types.Implements(typ, r.writer) && !types.Implements(types.NewPointer(typ), r.writer) {
+		return nil, nil
+	}
+	named := typ
+	if ptr, ok := named.(*types.Pointer); ok {
+		named = ptr.Elem()
+	}
+	if r.allowed[types.TypeString(named, nil)] {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewIgnoredWrite detects the results of Write and WriteString ignored on the
+// types implementing io.Writer, hiding short and failed writes. The types whose
+// writes never fail, such as bytes.Buffer, are allowed and can be configured by
+// their full name:
+//
+//	{"G145": {"types": ["bytes.Buffer", "strings.Builder"]}}
+func NewIgnoredWrite(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	allowed := map[string]bool{
+		"bytes.Buffer":    true,
+		"strings.Builder": true,
+		"hash.Hash":       true,
+		"hash.Hash32":     true,
+		"hash.Hash64":     true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				allowed = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						allowed[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	// io.Writer is built rather than imported since the analyzed packages may not import io
+	params := types.NewTuple(types.NewVar(0, nil, "p", types.NewSlice(types.Typ[types.Byte])))
+	results := types.NewTuple(
+		types.NewVar(0, nil, "n", types.Typ[types.Int]),
+		types.NewVar(0, nil, "err", types.Universe.Lookup("error").Type()),
+	)
+	write := types.NewFunc(0, nil, "Write", types.NewSignature(nil, params, results, false))
+	writer := types.NewInterfaceType([]*types.Func{write}, nil).Complete()
+
+	return &ignoredWrite{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Result of Write ignored, check the error and the number of bytes written",
+		},
+		writer:  writer,
+		allowed: allowed,
+	}, []ast.Node{(*ast.ExprStmt)(nil)}
+}
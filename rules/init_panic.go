@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type panicInInit struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *panicInInit) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *panicInInit) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	isPanic := false
+	if fun, ok := call.Fun.(*ast.Ident); ok {
+		isPanic = ctx.Info.Uses[fun] == types.Universe.Lookup("panic")
+	}
+	if !isPanic && r.calls.ContainsPkgCallExpr(call, ctx, false) == nil {
+		return nil, nil
+	}
+
+	// closures created by init may run much later, e.g. as handlers
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, call.Pos(), call.End())
+	for _, node := range path {
+		switch fn := node.(type) {
+		case *ast.FuncLit:
+			return nil, nil
+		case *ast.FuncDecl:
+			if fn.Recv != nil || fn.Name.Name != "init" {
+				return nil, nil
+			}
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewPanicInInit detects init functions which panic or terminate the process,
+// crashing every binary importing the package before main even starts.
+func NewPanicInInit(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("os", "Exit")
+	calls.AddAll("log", "Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln")
+	return &panicInInit{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "init function panics or terminates the process, initialize lazily and return an error instead",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultKeyFilePattern matches the names of the files holding key material
+const defaultKeyFilePattern = `(?i)(key|priv|secret|wallet)`
+
+type keyFilePermissions struct {
+	gosec.MetaData
+	gosec.CallList
+	pattern *regexp.Regexp
+}
+
+func (r *keyFilePermissions) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *keyFilePermissions) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call := r.ContainsPkgCallExpr(n, ctx, false)
+	if call == nil || len(call.Args) < 2 {
+		return nil, nil
+	}
+	tv, ok := ctx.Info.Types[call.Args[len(call.Args)-1]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return nil, nil
+	}
+	// only the owner may read and write the key material
+	mode, ok := constant.Int64Val(tv.Value)
+	if !ok || mode&^0600 == 0 {
+		return nil, nil
+	}
+	if r.isKeyFile(call.Args[0], ctx) {
+		return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isKeyFile returns true if one of the variables or string constants the path
+// is built from matches the pattern of the key files.
+func (r *keyFilePermissions) isKeyFile(path ast.Expr, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(path, func(node ast.Node) bool {
+		switch expr := node.(type) {
+		case *ast.Ident:
+			switch ctx.Info.ObjectOf(expr).(type) {
+			case *types.Var, *types.Const:
+				found = r.pattern.MatchString(expr.Name)
+			}
+		case *ast.BasicLit:
+			if tv, ok := ctx.Info.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+				found = r.pattern.MatchString(constant.StringVal(tv.Value))
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewKeyFilePerms detects files holding key material, e.g. the private keys of
+// the nodes or the wallets, which are written or chmoded with permissions broader
+// than 0600. The key files are identified by their path or by the name of the
+// variables the path is built from, matched with a pattern which can be
+// configured:
+//
+//	{"G310": {"pattern": "(?i)(key|priv|secret|wallet)"}}
+func NewKeyFilePerms(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultKeyFilePattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultKeyFilePattern)
+	}
+	rule := &keyFilePermissions{
+		CallList: gosec.NewCallList(),
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.Medium,
+			What:       "Key material written with permissions broader than 0600",
+		},
+		pattern: re,
+	}
+	rule.AddAll("os", "WriteFile", "OpenFile", "Chmod")
+	rule.Add("io/ioutil", "WriteFile")
+	return rule, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type lockCopy struct {
+	gosec.MetaData
+}
+
+func (r *lockCopy) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *lockCopy) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.FuncDecl:
+		for _, fields := range []*ast.FieldList{node.Recv, node.Type.Params, node.Type.Results} {
+			if fields == nil {
+				continue
+			}
+			for _, field := range fields.List {
+				if containsLock(ctx.Info.TypeOf(field.Type), map[types.Type]bool{}) {
+					return gosec.NewIssue(ctx, field, r.ID(), r.What, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	case *ast.RangeStmt:
+		if node.Value != nil && containsLock(ctx.Info.TypeOf(node.Value), map[types.Type]bool{}) {
+			return gosec.NewIssue(ctx, node.Value, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.AssignStmt:
+		for _, rhs := range node.Rhs {
+			switch rhs.(type) {
+			case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.StarExpr:
+			default:
+				// composite literals and calls create a new value
+				continue
+			}
+			if containsLock(ctx.Info.TypeOf(rhs), map[types.Type]bool{}) {
+				return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// containsLock returns true if the type holds a sync.Mutex, sync.RWMutex or
+// sync.WaitGroup by value, including through embedded structs and arrays.
+func containsLock(t types.Type, seen map[types.Type]bool) bool {
+	if t == nil || seen[t] {
+		return false
+	}
+	seen[t] = true
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "sync" {
+			switch obj.Name() {
+			case "Mutex", "RWMutex", "WaitGroup":
+				return true
+			}
+		}
+	}
+	switch typ := t.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if containsLock(typ.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	case *types.Array:
+		return containsLock(typ.Elem(), seen)
+	}
+	return false
+}
+
+// NewLockCopy detects values holding a sync lock which are copied by value receivers,
+// parameters, results, range values or assignments, in which case the copy has its
+// own state and no longer protects the original.
+func NewLockCopy(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &lockCopy{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Value containing a sync lock is copied, use a pointer instead",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil), (*ast.RangeStmt)(nil), (*ast.AssignStmt)(nil)}
+}
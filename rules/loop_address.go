@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type loopVariableAddress struct {
+	gosec.MetaData
+	enabled bool
+}
+
+func (r *loopVariableAddress) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *loopVariableAddress) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	unary, ok := n.(*ast.UnaryExpr)
+	if !r.enabled || !ok || unary.Op != token.AND {
+		return nil, nil
+	}
+
+	// &v escapes through append, go statements and closures while &s[i] is only
+	// affected when it is evaluated later by a closure
+	var ident *ast.Ident
+	indexed := false
+	switch x := unary.X.(type) {
+	case *ast.Ident:
+		ident = x
+	case *ast.IndexExpr:
+		ident, _ = x.Index.(*ast.Ident)
+		indexed = true
+	}
+	if ident == nil {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	if obj == nil {
+		return nil, nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, unary.Pos(), unary.End())
+	inClosure, inGoStmt, inAppend := false, false, false
+	for i := 1; i < len(path); i++ {
+		switch node := path[i].(type) {
+		case *ast.FuncDecl:
+			return nil, nil
+		case *ast.FuncLit:
+			inClosure = true
+		case *ast.GoStmt:
+			inGoStmt = true
+		case *ast.CallExpr:
+			if fun, ok := node.Fun.(*ast.Ident); ok && i == 1 && ctx.Info.Uses[fun] == types.Universe.Lookup("append") {
+				inAppend = true
+			}
+		case *ast.RangeStmt, *ast.ForStmt:
+			if !definesLoopVariable(node, obj, ctx) {
+				continue
+			}
+			if inClosure || (!indexed && (inGoStmt || inAppend)) {
+				return gosec.NewIssue(ctx, unary, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+// definesLoopVariable returns true if the object is declared by the range
+// clause or the init statement of the loop.
+func definesLoopVariable(loop ast.Node, obj types.Object, ctx *gosec.Context) bool {
+	var idents []ast.Expr
+	switch stmt := loop.(type) {
+	case *ast.RangeStmt:
+		if stmt.Tok != token.DEFINE {
+			return false
+		}
+		idents = []ast.Expr{stmt.Key, stmt.Value}
+	case *ast.ForStmt:
+		init, ok := stmt.Init.(*ast.AssignStmt)
+		if !ok || init.Tok != token.DEFINE {
+			return false
+		}
+		idents = init.Lhs
+	}
+	for _, expr := range idents {
+		if ident, ok := expr.(*ast.Ident); ok && ctx.Info.Defs[ident] == obj {
+			return true
+		}
+	}
+	return false
+}
+
+// loopVariablesShared returns false when the configured Go version gives every
+// iteration of a loop its own loop variables.
+func loopVariablesShared(id string, conf gosec.Config) bool {
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if version, ok := settings["goVersion"].(string); ok {
+				var major, minor int
+				if _, err := fmt.Sscanf(strings.TrimPrefix(version, "go"), "%d.%d", &major, &minor); err == nil {
+					return major < 1 || (major == 1 && minor < 22)
+				}
+			}
+		}
+	}
+	return true
+}
+
+// hasGoVersion returns true if the Go version of the project is configured
+func hasGoVersion(id string, conf gosec.Config) bool {
+	settings, ok := conf[id].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = settings["goVersion"].(string)
+	return ok
+}
+
+// NewLoopVariableAddress detects addresses of loop variables which escape the
+// iteration, in which case all the iterations share the same variable before
+// Go 1.22. The rule is opt-in and only runs when the project is configured with
+// a version of Go older than 1.22:
+//
+//	{"G603": {"goVersion": "1.21"}}
+func NewLoopVariableAddress(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &loopVariableAddress{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Address of a loop variable escapes the iteration and is shared by all iterations",
+		},
+		enabled: hasGoVersion(id, conf) && loopVariablesShared(id, conf),
+	}, []ast.Node{(*ast.UnaryExpr)(nil)}
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type loopVariableCapture struct {
+	gosec.MetaData
+	enabled bool
+}
+
+func (r *loopVariableCapture) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *loopVariableCapture) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	lit, ok := n.(*ast.FuncLit)
+	if !r.enabled || !ok {
+		return nil, nil
+	}
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, lit.Pos(), lit.End())
+	for len(path) > 0 && path[0] != lit {
+		path = path[1:]
+	}
+	if len(path) < 2 || !outlivesIteration(lit, path[1:]) {
+		return nil, nil
+	}
+
+	// loop variables declared by the loops enclosing the literal
+	var loops []ast.Node
+	for _, node := range path[1:] {
+		if _, ok := node.(*ast.FuncDecl); ok {
+			break
+		}
+		switch node.(type) {
+		case *ast.RangeStmt, *ast.ForStmt:
+			loops = append(loops, node)
+		}
+	}
+	if len(loops) == 0 {
+		return nil, nil
+	}
+	var captured *ast.Ident
+	ast.Inspect(lit.Body, func(node ast.Node) bool {
+		ident, ok := node.(*ast.Ident)
+		if !ok || captured != nil {
+			return captured == nil
+		}
+		obj, ok := ctx.Info.Uses[ident].(*types.Var)
+		if !ok {
+			return true
+		}
+		for _, loop := range loops {
+			if definesLoopVariable(loop, obj, ctx) {
+				captured = ident
+			}
+		}
+		return true
+	})
+	if captured == nil {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, captured, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// outlivesIteration returns true if the function literal is started as a
+// goroutine, deferred, stored or registered as a callback rather than called
+// within the iteration.
+func outlivesIteration(lit *ast.FuncLit, parents []ast.Node) bool {
+	switch parent := parents[0].(type) {
+	case *ast.AssignStmt, *ast.ValueSpec, *ast.CompositeLit, *ast.KeyValueExpr, *ast.SendStmt:
+		return true
+	case *ast.CallExpr:
+		if parent.Fun == lit {
+			// func() { ... }() runs immediately unless it is started as a goroutine
+			// or deferred
+			if len(parents) > 1 {
+				switch parents[1].(type) {
+				case *ast.GoStmt, *ast.DeferStmt:
+					return true
+				}
+			}
+			return false
+		}
+		var name string
+		switch fun := parent.Fun.(type) {
+		case *ast.Ident:
+			name = fun.Name
+		case *ast.SelectorExpr:
+			name = fun.Sel.Name
+		}
+		return name == "append" || name == "Go" ||
+			strings.HasPrefix(name, "Register") || strings.HasPrefix(name, "Handle") ||
+			strings.HasPrefix(name, "Subscribe") || strings.HasPrefix(name, "AfterFunc")
+	}
+	return false
+}
+
+// NewLoopVariableCapture detects function literals capturing a loop variable
+// which outlive the iteration, as goroutines, deferred calls, stored functions
+// or callbacks such as errgroup.Group.Go, in which case they all observe the last
+// value of the variable before Go 1.22. The rule is opt-in and only runs when
+// the project is configured with a version of Go older than 1.22:
+//
+//	{"G604": {"goVersion": "1.21"}}
+func NewLoopVariableCapture(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &loopVariableCapture{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Loop variable captured by a function literal which outlives the iteration",
+		},
+		enabled: hasGoVersion(id, conf) && loopVariablesShared(id, conf),
+	}, []ast.Node{(*ast.FuncLit)(nil)}
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type staticErrorInLoop struct {
+	gosec.MetaData
+}
+
+func (r *staticErrorInLoop) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *staticErrorInLoop) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, matched := gosec.MatchCallByPackage(n, ctx, "errors", "New")
+	if !matched {
+		call, matched = gosec.MatchCallByPackage(n, ctx, "fmt", "Errorf")
+	}
+	// Errors with a dynamic message or formatting arguments have to be built each time.
+	if !matched || len(call.Args) != 1 {
+		return nil, nil
+	}
+	if tv, ok := ctx.Info.Types[call.Args[0]]; !ok || tv.Value == nil {
+		return nil, nil
+	}
+	if !gosec.IsInsideLoop(call, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewStaticErrorInLoop detects identical errors allocated on every iteration of
+// a loop, which should rather be declared once as package level sentinels.
+func NewStaticErrorInLoop(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &staticErrorInLoop{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Constant error created inside a loop, declare it once as a package level variable",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
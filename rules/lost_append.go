@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type lostAppend struct {
+	gosec.MetaData
+}
+
+func (r *lostAppend) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *lostAppend) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return nil, nil
+	}
+	if fun, ok := call.Fun.(*ast.Ident); !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("append") {
+		return nil, nil
+	}
+	src, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	dst, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	fn := gosec.GetEnclosingFuncDecl(assign, ctx)
+	if fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+
+	srcObj := ctx.Info.ObjectOf(src)
+	if dst.Name == "_" {
+		return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	// the result is kept in a local variable which is never read while the
+	// original slice keeps being used as if it grew
+	dstObj := ctx.Info.ObjectOf(dst)
+	if dstObj == nil || dstObj == srcObj || dstObj.Pos() < fn.Body.Pos() || dstObj.Pos() > fn.Body.End() {
+		return nil, nil
+	}
+	if !usedAfter(fn.Body, dstObj, assign, ctx) && usedAfter(fn.Body, srcObj, assign, ctx) {
+		return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// usedAfter returns true if the object is referred to after the given node
+func usedAfter(body *ast.BlockStmt, obj types.Object, after ast.Node, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && ident.Pos() > after.End() && ctx.Info.Uses[ident] == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewLostAppend detects slices appended to whose result is discarded or kept in a
+// variable which is never used, while the original slice is used afterwards as
+// if it contained the new elements.
+func NewLostAppend(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &lostAppend{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Result of append is lost, assign it back to the slice",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type manualAbs struct {
+	gosec.MetaData
+	suggestBuiltins bool
+}
+
+func (r *manualAbs) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *manualAbs) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	ifStmt, ok := n.(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil || len(ifStmt.Body.List) != 1 {
+		return nil, nil
+	}
+	cond, ok := ifStmt.Cond.(*ast.BinaryExpr)
+	if !ok {
+		return nil, nil
+	}
+	if x := negativeCheckOperand(cond, ctx); x != nil && isSignedInteger(x, ctx) && negates(ifStmt.Body.List[0], x) {
+		return gosec.NewIssue(ctx, ifStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	if r.suggestBuiltins && isManualMinMax(ifStmt, cond, ctx) {
+		what := "Hand-rolled min or max, use the builtin min and max functions"
+		return gosec.NewIssue(ctx, ifStmt, r.ID(), what, gosec.Low, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// negativeCheckOperand returns x for the conditions x < 0, x <= 0, 0 > x and 0 >= x
+func negativeCheckOperand(cond *ast.BinaryExpr, ctx *gosec.Context) ast.Expr {
+	isZero := func(expr ast.Expr) bool {
+		tv, ok := ctx.Info.Types[expr]
+		return ok && tv.Value != nil && tv.Value.String() == "0"
+	}
+	switch cond.Op {
+	case token.LSS, token.LEQ:
+		if isZero(cond.Y) {
+			return cond.X
+		}
+	case token.GTR, token.GEQ:
+		if isZero(cond.X) {
+			return cond.Y
+		}
+	}
+	return nil
+}
+
+func isSignedInteger(expr ast.Expr, ctx *gosec.Context) bool {
+	basic, ok := typeUnderlying(expr, ctx).(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0 && basic.Info()&types.IsUnsigned == 0
+}
+
+// negates returns true for x = -x, x *= -1 and return -x
+func negates(stmt ast.Stmt, x ast.Expr) bool {
+	isNegation := func(expr ast.Expr) bool {
+		unary, ok := expr.(*ast.UnaryExpr)
+		return ok && unary.Op == token.SUB && types.ExprString(unary.X) == types.ExprString(x)
+	}
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if len(s.Lhs) != 1 || len(s.Rhs) != 1 || types.ExprString(s.Lhs[0]) != types.ExprString(x) {
+			return false
+		}
+		if s.Tok == token.MUL_ASSIGN {
+			if lit, ok := s.Rhs[0].(*ast.UnaryExpr); ok && lit.Op == token.SUB {
+				if one, ok := lit.X.(*ast.BasicLit); ok && one.Value == "1" {
+					return true
+				}
+			}
+			return false
+		}
+		return s.Tok == token.ASSIGN && isNegation(s.Rhs[0])
+	case *ast.ReturnStmt:
+		return len(s.Results) == 1 && isNegation(s.Results[0])
+	}
+	return false
+}
+
+// isManualMinMax returns true for "if a < b { return a }; return b" and its variants
+func isManualMinMax(ifStmt *ast.IfStmt, cond *ast.BinaryExpr, ctx *gosec.Context) bool {
+	switch cond.Op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+	default:
+		return false
+	}
+	basic, ok := typeUnderlying(cond.X, ctx).(*types.Basic)
+	if !ok || basic.Info()&types.IsOrdered == 0 {
+		return false
+	}
+	ret, ok := ifStmt.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return false
+	}
+
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, ifStmt.Pos(), ifStmt.End())
+	if len(path) < 2 {
+		return false
+	}
+	block, ok := path[1].(*ast.BlockStmt)
+	if !ok {
+		return false
+	}
+	for i, stmt := range block.List {
+		if stmt != ifStmt || i+1 >= len(block.List) {
+			continue
+		}
+		next, ok := block.List[i+1].(*ast.ReturnStmt)
+		if !ok || len(next.Results) != 1 {
+			return false
+		}
+		a, b := types.ExprString(cond.X), types.ExprString(cond.Y)
+		first, second := types.ExprString(ret.Results[0]), types.ExprString(next.Results[0])
+		return (first == a && second == b) || (first == b && second == a)
+	}
+	return false
+}
+
+// NewManualAbs detects absolute values computed by hand on signed integers, which
+// overflow for the minimum value of the type since -math.MinInt64 == math.MinInt64.
+// Projects using Go 1.21 or later can also have the hand-rolled min and max
+// reported in favor of the builtins:
+//
+//	{"G126": {"suggest_builtins": true}}
+func NewManualAbs(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	suggestBuiltins := false
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if suggest, ok := settings["suggest_builtins"].(bool); ok {
+				suggestBuiltins = suggest
+			}
+		}
+	}
+	return &manualAbs{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Hand-rolled absolute value overflows for the minimum value of the signed integer type",
+		},
+		suggestBuiltins: suggestBuiltins,
+	}, []ast.Node{(*ast.IfStmt)(nil)}
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type lostMapAppend struct {
+	gosec.MetaData
+}
+
+func (r *lostMapAppend) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *lostMapAppend) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != len(assign.Rhs) {
+		return nil, nil
+	}
+	for i, rhs := range assign.Rhs {
+		call, ok := rhs.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			continue
+		}
+		if fun, ok := call.Fun.(*ast.Ident); !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("append") {
+			continue
+		}
+		switch src := call.Args[0].(type) {
+		case *ast.IndexExpr:
+			// m[k] = append(m[k], v) is the only way to grow the value
+			if isMapIndex(src, ctx) && types.ExprString(assign.Lhs[i]) != types.ExprString(src) {
+				return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		case *ast.Ident:
+			// s := m[k]; s = append(s, v) grows a copy which must be stored back
+			dst, ok := assign.Lhs[i].(*ast.Ident)
+			if !ok || ctx.Info.ObjectOf(dst) != ctx.Info.ObjectOf(src) {
+				continue
+			}
+			if r.isLostCopy(assign, ctx.Info.ObjectOf(src), ctx) {
+				return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func isMapIndex(index *ast.IndexExpr, ctx *gosec.Context) bool {
+	t := ctx.Info.TypeOf(index.X)
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Map)
+	return ok
+}
+
+// isLostCopy returns true if the variable is assigned a map value before the
+// append and is never stored back into that map afterwards.
+func (r *lostMapAppend) isLostCopy(grow *ast.AssignStmt, obj types.Object, ctx *gosec.Context) bool {
+	fn := gosec.GetEnclosingFuncDecl(grow, ctx)
+	if fn == nil || fn.Body == nil || obj == nil {
+		return false
+	}
+	var source string
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Pos() >= grow.Pos() || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || ctx.Info.ObjectOf(ident) != obj {
+				continue
+			}
+			source = ""
+			if index, ok := assign.Rhs[i].(*ast.IndexExpr); ok && isMapIndex(index, ctx) {
+				source = types.ExprString(index.X)
+			}
+		}
+		return true
+	})
+	if source == "" {
+		return false
+	}
+
+	stored := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Pos() <= grow.End() || len(assign.Lhs) != len(assign.Rhs) {
+			return !stored
+		}
+		for i, lhs := range assign.Lhs {
+			index, ok := lhs.(*ast.IndexExpr)
+			if ok && types.ExprString(index.X) == source && usesAny(assign.Rhs[i], map[types.Object]bool{obj: true}, ctx) {
+				stored = true
+			}
+		}
+		return !stored
+	})
+	return !stored
+}
+
+// NewLostMapAppend detects appends to the slices held by a map whose result is not
+// stored back into the map. The map values are not addressable, so the appended
+// elements are lost unless the result is assigned back with m[k] = append(m[k], v).
+func NewLostMapAppend(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &lostMapAppend{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Result of append to a map value is not stored back into the map",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type nilMapWrite struct {
+	gosec.MetaData
+}
+
+func (r *nilMapWrite) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *nilMapWrite) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var targets []ast.Expr
+	switch stmt := n.(type) {
+	case *ast.AssignStmt:
+		targets = stmt.Lhs
+	case *ast.IncDecStmt:
+		targets = []ast.Expr{stmt.X}
+	default:
+		return nil, nil
+	}
+	for _, target := range targets {
+		index, ok := target.(*ast.IndexExpr)
+		if !ok {
+			continue
+		}
+		ident, ok := index.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		obj, ok := ctx.Info.ObjectOf(ident).(*types.Var)
+		if !ok {
+			continue
+		}
+		if _, ok := obj.Type().Underlying().(*types.Map); !ok {
+			continue
+		}
+		fn := gosec.GetEnclosingFuncDecl(n, ctx)
+		if fn == nil || fn.Body == nil || !declaredNil(fn.Body, obj) {
+			continue
+		}
+		if !assignedBefore(fn.Body, obj, n, ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// declaredNil returns true if the variable is declared in the function body
+// by a var declaration without any value, leaving the map nil.
+func declaredNil(body *ast.BlockStmt, obj types.Object) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || found {
+			return !found
+		}
+		for _, name := range spec.Names {
+			if name.Pos() == obj.Pos() && len(spec.Values) == 0 {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// assignedBefore returns true if the map is assigned or its address taken before
+// the write, in which case it may have been initialized.
+func assignedBefore(body *ast.BlockStmt, obj types.Object, write ast.Node, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if found || node == nil || node.Pos() >= write.Pos() {
+			return false
+		}
+		switch stmt := node.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range stmt.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+					found = true
+				}
+			}
+		case *ast.UnaryExpr:
+			if ident, ok := stmt.X.(*ast.Ident); ok && stmt.Op == token.AND && ctx.Info.ObjectOf(ident) == obj {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewNilMapWrite detects writes to maps declared without a value, which are nil
+// and panic when written unless the map is created with make or assigned first.
+func NewNilMapWrite(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &nilMapWrite{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Write to a nil map, create it with make first",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.IncDecStmt)(nil)}
+}
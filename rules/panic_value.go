@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type panicValue struct {
+	gosec.MetaData
+}
+
+func (r *panicValue) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *panicValue) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, nil
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("panic") {
+		return nil, nil
+	}
+	t := ctx.Info.TypeOf(call.Args[0])
+	if t == nil || isPanicFriendly(t) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isPanicFriendly returns true for strings and errors, which are expected by the
+// recovering code. Interfaces such as a re-panicked recovered value are unknown
+// and not reported either.
+func isPanicFriendly(t types.Type) bool {
+	switch typ := t.Underlying().(type) {
+	case *types.Basic:
+		if typ.Info()&types.IsString != 0 {
+			return true
+		}
+	case *types.Interface:
+		return true
+	}
+	return types.Implements(t, errorType) || types.Implements(types.NewPointer(t), errorType)
+}
+
+// NewPanicValue detects panics with values which are neither strings nor errors,
+// e.g. panic(42), which the recovering code usually fails to report.
+func NewPanicValue(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &panicValue{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Panic with a value which is neither an error nor a string",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
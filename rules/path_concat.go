@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type concatenatedPath struct {
+	gosec.MetaData
+	gosec.CallList
+	format gosec.CallList
+}
+
+func (r *concatenatedPath) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *concatenatedPath) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call := r.ContainsPkgCallExpr(n, ctx, false)
+	if call == nil || len(call.Args) == 0 {
+		return nil, nil
+	}
+	path := call.Args[0]
+	if r.isConcatenated(path, ctx) {
+		return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+
+	// the path is built beforehand and assigned to a local variable
+	ident, ok := path.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	fn := gosec.GetEnclosingFuncDecl(n, ctx)
+	if obj == nil || fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+	var issue *gosec.Issue
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		if issue != nil || node == nil || node.Pos() >= call.Pos() {
+			return false
+		}
+		var names, values []ast.Expr
+		switch stmt := node.(type) {
+		case *ast.AssignStmt:
+			names, values = stmt.Lhs, stmt.Rhs
+		case *ast.ValueSpec:
+			for _, name := range stmt.Names {
+				names = append(names, name)
+			}
+			values = stmt.Values
+		default:
+			return true
+		}
+		if len(names) != len(values) {
+			return true
+		}
+		for i, name := range names {
+			if name, ok := name.(*ast.Ident); ok && ctx.Info.ObjectOf(name) == obj && r.isConcatenated(values[i], ctx) {
+				issue = gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence)
+			}
+		}
+		return issue == nil
+	})
+	return issue, nil
+}
+
+// isConcatenated returns true for the concatenations and fmt.Sprintf calls
+// joining paths with a separator literal, e.g. dir + "/" + name.
+func (r *concatenatedPath) isConcatenated(expr ast.Expr, ctx *gosec.Context) bool {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return r.isConcatenated(expr.X, ctx)
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD || isConstant(expr, ctx) {
+			return false
+		}
+		return hasSeparator(expr.X) || hasSeparator(expr.Y) || r.isConcatenated(expr.X, ctx) || r.isConcatenated(expr.Y, ctx)
+	case *ast.CallExpr:
+		return r.format.ContainsPkgCallExpr(expr, ctx, false) != nil && len(expr.Args) > 1 && hasSeparator(expr.Args[0])
+	}
+	return false
+}
+
+func hasSeparator(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return err == nil && strings.ContainsAny(value, `/\`)
+}
+
+// NewConcatenatedPath detects file paths built by concatenating strings with a
+// separator, or with fmt.Sprintf, before being passed to a file function. These
+// paths are not cleaned, break on the platforms using another separator and may
+// be traversed with "..", filepath.Join should be used instead.
+func NewConcatenatedPath(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	rule := &concatenatedPath{
+		CallList: gosec.NewCallList(),
+		format:   gosec.NewCallList(),
+		MetaData: gosec.MetaData{
+			ID:         id,
+			What:       "File path built by concatenation, use filepath.Join instead",
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+		},
+	}
+	rule.format.Add("fmt", "Sprintf")
+	rule.AddAll("os", "Open", "OpenFile", "Create", "ReadFile", "WriteFile", "ReadDir",
+		"Remove", "RemoveAll", "Mkdir", "MkdirAll", "Stat", "Lstat", "Chmod", "Rename")
+	rule.AddAll("io/ioutil", "ReadFile", "WriteFile", "ReadDir")
+	return rule, []ast.Node{(*ast.CallExpr)(nil)}
+}
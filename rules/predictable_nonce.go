@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultNoncePattern matches the names of the values which must be unique and
+// unpredictable
+const defaultNoncePattern = `(?i)(nonce|salt|token|session_?id)$`
+
+type predictableNonce struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+}
+
+func (r *predictableNonce) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *predictableNonce) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var names, values []ast.Expr
+	switch node := n.(type) {
+	case *ast.AssignStmt:
+		names, values = node.Lhs, node.Rhs
+	case *ast.ValueSpec:
+		for _, name := range node.Names {
+			names = append(names, name)
+		}
+		values = node.Values
+	case *ast.KeyValueExpr:
+		names, values = []ast.Expr{node.Key}, []ast.Expr{node.Value}
+	default:
+		return nil, nil
+	}
+	if len(names) != len(values) {
+		return nil, nil
+	}
+	for i, name := range names {
+		var ident *ast.Ident
+		switch expr := name.(type) {
+		case *ast.Ident:
+			ident = expr
+		case *ast.SelectorExpr:
+			ident = expr.Sel
+		}
+		if ident != nil && r.pattern.MatchString(ident.Name) && isPredictable(values[i], ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isPredictable returns true if the value is derived from the current time, from
+// math/rand or from a package level counter.
+func isPredictable(value ast.Expr, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(value, func(node ast.Node) bool {
+		switch expr := node.(type) {
+		case *ast.SelectorExpr:
+			obj := ctx.Info.Uses[expr.Sel]
+			if obj == nil || obj.Pkg() == nil {
+				break
+			}
+			switch path := obj.Pkg().Path(); {
+			case path == "math/rand" || path == "math/rand/v2":
+				found = true
+			case path == "time" && strings.HasPrefix(obj.Name(), "Unix"):
+				found = true
+			case path == "sync/atomic" && strings.HasPrefix(obj.Name(), "Add"):
+				found = true
+			}
+		case *ast.Ident:
+			if v, ok := ctx.Info.Uses[expr].(*types.Var); ok && v.Pkg() != nil && v.Parent() == v.Pkg().Scope() {
+				basic, ok := v.Type().Underlying().(*types.Basic)
+				found = ok && basic.Info()&types.IsInteger != 0
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewPredictableNonce detects nonces, salts, tokens and session IDs generated
+// from the current time, math/rand or an incremented counter, which can be
+// guessed or collide. They should be read from crypto/rand instead. The names of
+// the values are matched with a pattern which can be configured:
+//
+//	{"G407": {"pattern": "(?i)(nonce|salt)$"}}
+func NewPredictableNonce(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultNoncePattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultNoncePattern)
+	}
+	return &predictableNonce{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Nonce or identifier generated from a predictable source, use crypto/rand instead",
+		},
+		pattern: re,
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil), (*ast.KeyValueExpr)(nil)}
+}
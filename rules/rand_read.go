@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type uncheckedRandRead struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *uncheckedRandRead) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *uncheckedRandRead) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch stmt := n.(type) {
+	case *ast.ExprStmt:
+		if call, ok := stmt.X.(*ast.CallExpr); ok && r.readsRandom(call, ctx) {
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.AssignStmt:
+		if len(stmt.Rhs) != 1 {
+			return nil, nil
+		}
+		call, ok := stmt.Rhs[0].(*ast.CallExpr)
+		if !ok || !r.readsRandom(call, ctx) {
+			return nil, nil
+		}
+		pos := returnsError(call, ctx)
+		if pos < 0 || pos >= len(stmt.Lhs) {
+			return nil, nil
+		}
+		if id, ok := stmt.Lhs[pos].(*ast.Ident); ok && id.Name == "_" {
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// readsRandom returns true for rand.Read and for io.ReadFull or io.ReadAtLeast
+// reading from rand.Reader of crypto/rand
+func (r *uncheckedRandRead) readsRandom(call *ast.CallExpr, ctx *gosec.Context) bool {
+	if r.calls.ContainsPkgCallExpr(call, ctx, false) == nil {
+		return false
+	}
+	selector, ident, err := gosec.GetCallInfo(call, ctx)
+	if err != nil {
+		return false
+	}
+	if path, _ := gosec.GetImportPath(selector, ctx); path == "crypto/rand" && ident == "Read" {
+		return true
+	}
+	if len(call.Args) == 0 {
+		return false
+	}
+	reader, ok := call.Args[0].(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	v, ok := ctx.Info.Uses[reader.Sel].(*types.Var)
+	return ok && v.Pkg() != nil && v.Pkg().Path() == "crypto/rand" && v.Name() == "Reader"
+}
+
+// NewUncheckedRandRead detects reads of crypto/rand whose error is ignored, in
+// which case the buffer used as a key or a nonce may not be random at all.
+func NewUncheckedRandRead(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("crypto/rand", "Read")
+	calls.AddAll("io", "ReadFull", "ReadAtLeast")
+	return &uncheckedRandRead{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Error of the random read ignored, the buffer may not be random",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type appendWhileRanging struct {
+	gosec.MetaData
+}
+
+func (r *appendWhileRanging) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *appendWhileRanging) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok {
+		return nil, nil
+	}
+	ranged, ok := rangeStmt.X.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(ranged)
+	if obj == nil {
+		return nil, nil
+	}
+	if _, ok := obj.Type().Underlying().(*types.Slice); !ok {
+		return nil, nil
+	}
+
+	var issue *gosec.Issue
+	ast.Inspect(rangeStmt.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || issue != nil || len(call.Args) == 0 {
+			return issue == nil
+		}
+		fun, ok := call.Fun.(*ast.Ident)
+		if !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("append") {
+			return true
+		}
+		if arg, ok := call.Args[0].(*ast.Ident); ok && ctx.Info.ObjectOf(arg) == obj {
+			issue = gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence)
+		}
+		return issue == nil
+	})
+	return issue, nil
+}
+
+// NewAppendWhileRanging detects slices appended to while ranging over them. The
+// range expression is evaluated once, so the loop never visits the appended
+// elements, which is rarely what the code intends.
+func NewAppendWhileRanging(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &appendWhileRanging{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Slice appended to while ranging over it, the loop does not visit the appended elements",
+		},
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
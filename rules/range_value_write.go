@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type rangeValueWrite struct {
+	gosec.MetaData
+}
+
+func (r *rangeValueWrite) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *rangeValueWrite) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok || rangeStmt.Tok != token.DEFINE {
+		return nil, nil
+	}
+	value, ok := rangeStmt.Value.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj := ctx.Info.Defs[value]
+	if obj == nil {
+		return nil, nil
+	}
+	switch obj.Type().Underlying().(type) {
+	case *types.Struct, *types.Array:
+	default:
+		return nil, nil
+	}
+
+	// the write is only lost when the copy is not used as a whole afterwards,
+	// e.g. stored back with s[i] = v or appended to another slice
+	var write ast.Node
+	usedAsWhole := false
+	ast.Inspect(rangeStmt.Body, func(node ast.Node) bool {
+		switch stmt := node.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range stmt.Lhs {
+				if write == nil && writesToCopyOf(lhs, obj, ctx) {
+					write = stmt
+				}
+			}
+		case *ast.IncDecStmt:
+			if write == nil && writesToCopyOf(stmt.X, obj, ctx) {
+				write = stmt
+			}
+		case *ast.SelectorExpr:
+			if ident, ok := stmt.X.(*ast.Ident); ok && ctx.Info.Uses[ident] == obj {
+				if sel, ok := ctx.Info.Selections[stmt]; !ok || sel.Kind() != types.FieldVal {
+					usedAsWhole = true
+				}
+				return false
+			}
+		case *ast.IndexExpr:
+			if ident, ok := stmt.X.(*ast.Ident); ok && ctx.Info.Uses[ident] == obj {
+				ast.Inspect(stmt.Index, func(index ast.Node) bool {
+					if ident, ok := index.(*ast.Ident); ok && ctx.Info.Uses[ident] == obj {
+						usedAsWhole = true
+					}
+					return true
+				})
+				return false
+			}
+		case *ast.Ident:
+			if ctx.Info.Uses[stmt] == obj {
+				usedAsWhole = true
+			}
+		}
+		return true
+	})
+	if write == nil || usedAsWhole {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, write, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// writesToCopyOf returns true for v.Field, v.a.b or v[i] where v is the object
+func writesToCopyOf(expr ast.Expr, obj types.Object, ctx *gosec.Context) bool {
+	for {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.SelectorExpr:
+			sel, ok := ctx.Info.Selections[e]
+			if !ok || sel.Kind() != types.FieldVal || sel.Indirect() {
+				return false
+			}
+			if ident, ok := e.X.(*ast.Ident); ok {
+				return ctx.Info.Uses[ident] == obj
+			}
+			expr = e.X
+		case *ast.IndexExpr:
+			if _, ok := typeUnderlying(e.X, ctx).(*types.Array); !ok {
+				return false
+			}
+			if ident, ok := e.X.(*ast.Ident); ok {
+				return ctx.Info.Uses[ident] == obj
+			}
+			expr = e.X
+		default:
+			return false
+		}
+	}
+}
+
+// NewRangeValueWrite detects writes to the fields of a range value variable, which
+// is a copy of the element when the elements are structs or arrays, so that the
+// write is lost at the end of the iteration.
+func NewRangeValueWrite(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &rangeValueWrite{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Write to a copy of the range element is lost, index the slice to modify the element",
+		},
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
@@ -18,14 +18,17 @@ import (
 	"go/ast"
 	"go/types"
 
+	"golang.org/x/tools/go/ast/astutil"
+
 	"github.com/cosmos/gosec/v2"
 )
 
 type readfile struct {
 	gosec.MetaData
 	gosec.CallList
-	pathJoin gosec.CallList
-	clean    gosec.CallList
+	pathJoin   gosec.CallList
+	clean      gosec.CallList
+	validators map[string]bool
 }
 
 // ID returns the identifier for this rule
@@ -59,7 +62,7 @@ func (r *readfile) isJoinFunc(n ast.Node, c *gosec.Context) bool {
 
 // isFilepathClean checks if there is a filepath.Clean before assigning to a variable
 func (r *readfile) isFilepathClean(n *ast.Ident, c *gosec.Context) bool {
-	if n.Obj.Kind != ast.Var {
+	if n.Obj == nil || n.Obj.Kind != ast.Var {
 		return false
 	}
 	if node, ok := n.Obj.Decl.(*ast.AssignStmt); ok {
@@ -72,6 +75,126 @@ func (r *readfile) isFilepathClean(n *ast.Ident, c *gosec.Context) bool {
 	return false
 }
 
+// isValidated checks if the path is cleaned by a reassignment such as
+// p = filepath.Clean(p), or validated in the condition of an enclosing if
+// statement or of a preceding guard such as if !isAllowed(p) { return }
+func (r *readfile) isValidated(n *ast.Ident, c *gosec.Context) bool {
+	obj := c.Info.ObjectOf(n)
+	path, _ := astutil.PathEnclosingInterval(c.Root, n.Pos(), n.End())
+	for len(path) > 0 && path[0] != n {
+		path = path[1:]
+	}
+	for i := 1; i < len(path); i++ {
+		switch node := path[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if n.Pos() >= node.Body.Pos() && n.End() <= node.Body.End() && r.validates(node.Cond, obj, c) {
+				return true
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range node.List {
+				if stmt == path[i-1] {
+					break
+				}
+				switch stmt := stmt.(type) {
+				case *ast.AssignStmt:
+					for j, lhs := range stmt.Lhs {
+						if ident, ok := lhs.(*ast.Ident); ok && j < len(stmt.Rhs) && c.Info.ObjectOf(ident) == obj &&
+							r.clean.ContainsPkgCallExpr(stmt.Rhs[j], c, false) != nil {
+							return true
+						}
+					}
+				case *ast.IfStmt:
+					if terminates(stmt.Body) && (r.validates(stmt.Init, obj, c) || r.validates(stmt.Cond, obj, c)) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// validates returns true if the node cleans the path with filepath.Clean or
+// filepath.Rel, checks the prefix of the cleaned path with strings.HasPrefix, or
+// passes the path to one of the configured validators. Other calls, e.g. os.Stat
+// or len, say nothing about the path traversal.
+func (r *readfile) validates(n ast.Node, obj types.Object, c *gosec.Context) bool {
+	if n == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(n, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		if _, ok := gosec.MatchCallByPackage(call, c, "strings", "HasPrefix"); ok && len(call.Args) == 2 {
+			found = r.cleans(call.Args[0], obj, c)
+			return !found
+		}
+		if r.clean.ContainsPkgCallExpr(call, c, false) != nil || r.isValidator(call, c) {
+			for _, arg := range call.Args {
+				if usesAny(arg, map[types.Object]bool{obj: true}, c) {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// cleans returns true if the expression is the path cleaned by filepath.Clean or
+// filepath.Rel, either directly or through a variable assigned with it
+func (r *readfile) cleans(expr ast.Expr, obj types.Object, c *gosec.Context) bool {
+	if ident, ok := expr.(*ast.Ident); ok && ident.Obj != nil {
+		if assign, ok := ident.Obj.Decl.(*ast.AssignStmt); ok && len(assign.Rhs) > 0 {
+			expr = assign.Rhs[0]
+		}
+	}
+	call := r.clean.ContainsPkgCallExpr(expr, c, false)
+	if call == nil {
+		return false
+	}
+	for _, arg := range call.Args {
+		if usesAny(arg, map[types.Object]bool{obj: true}, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidator returns true if the call is one of the configured validators,
+// given by their name or by their full name
+func (r *readfile) isValidator(call *ast.CallExpr, c *gosec.Context) bool {
+	if len(r.validators) == 0 {
+		return false
+	}
+	_, obj := gosec.GetCallObject(call, c)
+	fn, ok := obj.(*types.Func)
+	return ok && (r.validators[fn.Name()] || r.validators[fn.FullName()])
+}
+
+// terminates returns true if the block ends by leaving the function or the loop
+func terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch last := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		if call, ok := last.X.(*ast.CallExpr); ok {
+			if fun, ok := call.Fun.(*ast.Ident); ok && fun.Name == "panic" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Match inspects AST nodes to determine if the match the methods `os.Open` or `ioutil.ReadFile`
 func (r *readfile) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
 	if node := r.ContainsPkgCallExpr(n, c, false); node != nil {
@@ -95,7 +218,8 @@ func (r *readfile) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
 				obj := c.Info.ObjectOf(ident)
 				if _, ok := obj.(*types.Var); ok &&
 					!gosec.TryResolve(ident, c) &&
-					!r.isFilepathClean(ident, c) {
+					!r.isFilepathClean(ident, c) &&
+					!r.isValidated(ident, c) {
 					return gosec.NewIssue(c, n, r.ID(), r.What, r.Severity, r.Confidence), nil
 				}
 			}
@@ -104,12 +228,30 @@ func (r *readfile) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
 	return nil, nil
 }
 
-// NewReadFile detects cases where we read files
+// NewReadFile detects cases where we read files from a path which is provided
+// as a variable, e.g. a parameter or request data, without being cleaned with
+// filepath.Clean or validated beforehand, which allows path traversal. The
+// functions validating the paths can be configured:
+//
+//	{"G304": {"validators": ["isAllowedPath", "example.com/app/fs.Validate"]}}
 func NewReadFile(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	validators := make(map[string]bool)
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["validators"].([]interface{}); ok {
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						validators[name] = true
+					}
+				}
+			}
+		}
+	}
 	rule := &readfile{
-		pathJoin: gosec.NewCallList(),
-		clean:    gosec.NewCallList(),
-		CallList: gosec.NewCallList(),
+		pathJoin:   gosec.NewCallList(),
+		clean:      gosec.NewCallList(),
+		validators: validators,
+		CallList:   gosec.NewCallList(),
 		MetaData: gosec.MetaData{
 			ID:         id,
 			What:       "Potential file inclusion via variable",
@@ -124,5 +266,7 @@ func NewReadFile(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	rule.Add("io/ioutil", "ReadFile")
 	rule.Add("os", "Open")
 	rule.Add("os", "OpenFile")
+	rule.Add("os", "ReadFile")
+	rule.Add("os", "Create")
 	return rule, []ast.Node{(*ast.CallExpr)(nil)}
 }
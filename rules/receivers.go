@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type mixedReceivers struct {
+	gosec.MetaData
+}
+
+func (r *mixedReceivers) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *mixedReceivers) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	spec, ok := n.(*ast.TypeSpec)
+	if !ok {
+		return nil, nil
+	}
+
+	var mutating, pointers []string
+	for _, file := range ctx.PkgFiles {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+			recv := fn.Recv.List[0]
+			name, pointer := receiverType(recv.Type)
+			if name != spec.Name.Name {
+				continue
+			}
+			if pointer {
+				pointers = append(pointers, fn.Name.Name)
+			} else if mutatesReceiver(fn, recv, ctx) {
+				mutating = append(mutating, fn.Name.Name)
+			}
+		}
+	}
+
+	// Value receivers which only read the receiver are fine to mix with pointer receivers.
+	if len(mutating) == 0 || len(pointers) == 0 {
+		return nil, nil
+	}
+	what := fmt.Sprintf(r.What, spec.Name.Name, strings.Join(mutating, ", "), strings.Join(pointers, ", "))
+	return gosec.NewIssue(ctx, spec, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// receiverType returns the name of the receiver type and whether it is a pointer
+func receiverType(expr ast.Expr) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name, false
+	case *ast.StarExpr:
+		name, _ := receiverType(t.X)
+		return name, true
+	case *ast.IndexExpr:
+		return receiverType(t.X)
+	case *ast.ParenExpr:
+		return receiverType(t.X)
+	}
+	return "", false
+}
+
+// mutatesReceiver returns true if the method body writes to the copy of its value receiver
+func mutatesReceiver(fn *ast.FuncDecl, recv *ast.Field, ctx *gosec.Context) bool {
+	if fn.Body == nil || len(recv.Names) == 0 {
+		return false
+	}
+	obj := ctx.Info.Defs[recv.Names[0]]
+	if obj == nil {
+		return false
+	}
+
+	mutates := false
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range stmt.Lhs {
+				if writesToCopy(lhs, obj, ctx) {
+					mutates = true
+				}
+			}
+		case *ast.IncDecStmt:
+			if writesToCopy(stmt.X, obj, ctx) {
+				mutates = true
+			}
+		}
+		return !mutates
+	})
+	return mutates
+}
+
+// writesToCopy returns true if the assigned expression is rooted in obj without
+// going through a pointer, map or slice which would make the write visible to the caller.
+func writesToCopy(expr ast.Expr, obj types.Object, ctx *gosec.Context) bool {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return ctx.Info.ObjectOf(e) == obj
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.SelectorExpr:
+			if _, ok := typeUnderlying(e.X, ctx).(*types.Pointer); ok {
+				return false
+			}
+			expr = e.X
+		case *ast.IndexExpr:
+			if _, ok := typeUnderlying(e.X, ctx).(*types.Array); !ok {
+				return false
+			}
+			expr = e.X
+		default:
+			return false
+		}
+	}
+}
+
+func typeUnderlying(expr ast.Expr, ctx *gosec.Context) types.Type {
+	if t := ctx.Info.TypeOf(expr); t != nil {
+		return t.Underlying()
+	}
+	return nil
+}
+
+// NewMixedReceivers detects types whose methods are declared partly on value and
+// partly on pointer receivers while the value receivers modify their copy.
+func NewMixedReceivers(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &mixedReceivers{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Type %s mixes value receivers which modify a copy (%s) with pointer receivers (%s)",
+		},
+	}, []ast.Node{(*ast.TypeSpec)(nil)}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type recoverNotDeferred struct {
+	gosec.MetaData
+}
+
+func (r *recoverNotDeferred) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *recoverNotDeferred) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || ctx.Info.Uses[fun] != types.Universe.Lookup("recover") {
+		return nil, nil
+	}
+
+	// recover only stops a panic when it is called directly by a deferred function
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, call.Pos(), call.End())
+	for i, node := range path {
+		switch fn := node.(type) {
+		case *ast.FuncLit:
+			if isDeferredLiteral(path[i+1:]) {
+				return nil, nil
+			}
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		case *ast.FuncDecl:
+			if isDeferredDecl(fn, ctx) {
+				return nil, nil
+			}
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isDeferredLiteral returns true for "defer func() { ... }()" given the
+// ancestors of the function literal.
+func isDeferredLiteral(ancestors []ast.Node) bool {
+	if len(ancestors) < 2 {
+		return false
+	}
+	if _, ok := ancestors[0].(*ast.CallExpr); !ok {
+		return false
+	}
+	_, ok := ancestors[1].(*ast.DeferStmt)
+	return ok
+}
+
+// isDeferredDecl returns true if the function is deferred anywhere in its package
+func isDeferredDecl(fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	obj := ctx.Info.Defs[fn.Name]
+	if obj == nil {
+		return false
+	}
+	found := false
+	for _, file := range ctx.PkgFiles {
+		ast.Inspect(file, func(node ast.Node) bool {
+			deferStmt, ok := node.(*ast.DeferStmt)
+			if !ok {
+				return !found
+			}
+			var ident *ast.Ident
+			switch fun := deferStmt.Call.Fun.(type) {
+			case *ast.Ident:
+				ident = fun
+			case *ast.SelectorExpr:
+				ident = fun.Sel
+			}
+			if ident != nil && ctx.Info.Uses[ident] == obj {
+				found = true
+			}
+			return !found
+		})
+		if found {
+			break
+		}
+	}
+	return found
+}
+
+// NewRecoverNotDeferred detects calls to recover outside of deferred functions,
+// where they always return nil and never stop a panic.
+func NewRecoverNotDeferred(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &recoverNotDeferred{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "recover is not called directly by a deferred function and never stops a panic",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type regexpInFunction struct {
+	gosec.MetaData
+}
+
+func (r *regexpInFunction) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *regexpInFunction) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, matched := gosec.MatchCallByPackage(n, ctx, "regexp", "Compile", "MustCompile", "CompilePOSIX", "MustCompilePOSIX")
+	if !matched || len(call.Args) != 1 {
+		return nil, nil
+	}
+	// Dynamic patterns can only be compiled where they are known.
+	if tv, ok := ctx.Info.Types[call.Args[0]]; !ok || tv.Value == nil {
+		return nil, nil
+	}
+	// Package level variables and init functions are only evaluated once.
+	fn := gosec.GetEnclosingFuncDecl(call, ctx)
+	if fn == nil || (fn.Recv == nil && fn.Name.Name == "init") {
+		return nil, nil
+	}
+	severity := r.Severity
+	if gosec.IsInsideLoop(call, ctx) {
+		severity = gosec.Medium
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, severity, r.Confidence), nil
+}
+
+// NewRegexpInFunction detects constant regular expressions compiled on every
+// call of a function instead of once at package level.
+func NewRegexpInFunction(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &regexpInFunction{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Constant regular expression compiled inside a function, compile it once in a package level variable",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
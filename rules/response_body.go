@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unclosedResponseBody struct {
+	gosec.MetaData
+}
+
+func (r *unclosedResponseBody) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *unclosedResponseBody) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 || len(assign.Lhs) == 0 {
+		return nil, nil
+	}
+	if _, ok := assign.Rhs[0].(*ast.CallExpr); !ok {
+		return nil, nil
+	}
+	resp, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || !isHTTPResponse(ctx.Info.TypeOf(assign.Lhs[0])) {
+		return nil, nil
+	}
+	fn := gosec.GetEnclosingFuncDecl(assign, ctx)
+	if fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(resp)
+	if obj != nil && closesOrReturns(fn.Body, obj, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isHTTPResponse returns true for *net/http.Response
+func isHTTPResponse(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "net/http" && named.Obj().Name() == "Response"
+}
+
+// closesOrReturns returns true if the function calls resp.Body.Close() anywhere,
+// including after checking the error of the request, or hands the response over
+// to its caller.
+func closesOrReturns(body *ast.BlockStmt, resp types.Object, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch stmt := node.(type) {
+		case *ast.CallExpr:
+			closeSel, ok := stmt.Fun.(*ast.SelectorExpr)
+			if !ok || closeSel.Sel.Name != "Close" {
+				break
+			}
+			bodySel, ok := closeSel.X.(*ast.SelectorExpr)
+			if !ok || bodySel.Sel.Name != "Body" {
+				break
+			}
+			if ident, ok := bodySel.X.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == resp {
+				found = true
+			}
+		case *ast.ReturnStmt:
+			for _, result := range stmt.Results {
+				if ident, ok := result.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == resp {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnclosedResponseBody detects HTTP responses whose body is never closed,
+// which leaks the underlying connections.
+func NewUnclosedResponseBody(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unclosedResponseBody{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "HTTP response body is not closed, add defer resp.Body.Close()",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
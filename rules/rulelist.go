@@ -79,6 +79,45 @@ func Generate(filters ...RuleFilter) RuleList {
 		{"G108", "Profiling endpoint is automatically exposed", NewPprofCheck},
 		{"G109", "Converting strconv.Atoi result to int32/int16", NewIntegerOverflowCheck},
 		{"G110", "Detect io.Copy instead of io.CopyN when decompression", NewDecompressionBombCheck},
+		{"G111", "Audit shadowing of the err variable", NewErrShadowing},
+		{"G112", "Mixed value and pointer receivers losing mutations", NewMixedReceivers},
+		{"G113", "Process terminated from library code", NewExitInLibrary},
+		{"G114", "Context values stored with keys of builtin types", NewContextKeyType},
+		{"G115", "Deferred call on a receiver which is reassigned", NewDeferredReceiverReassigned},
+		{"G116", "Constant error created inside a loop", NewStaticErrorInLoop},
+		{"G117", "Constant regular expression compiled inside a function", NewRegexpInFunction},
+		{"G118", "Deprecated or error prone standard library call", NewErrorProneCall},
+		{"G119", "HTTP response body not closed", NewUnclosedResponseBody},
+		{"G120", "Ignored number of elements copied", NewIgnoredCopyLength},
+		{"G121", "recover called outside of a deferred function", NewRecoverNotDeferred},
+		{"G122", "Slice bounds not checked against the length", NewUncheckedSliceBound},
+		{"G123", "Panic with a value which is neither an error nor a string", NewPanicValue},
+		{"G124", "Write to a copy of the range element", NewRangeValueWrite},
+		{"G125", "Discarded result of a function without side effects", NewDiscardedResult},
+		{"G126", "Hand-rolled absolute value of a signed integer", NewManualAbs},
+		{"G127", "Value holding a sync lock copied", NewLockCopy},
+		{"G128", "Channel operation ignoring the cancellation of the context", NewBlockingChannelOp},
+		{"G129", "Panic or process termination in init functions", NewPanicInInit},
+		{"G130", "Result of append lost", NewLostAppend},
+		{"G131", "Errors compared with sentinel errors", NewSentinelComparison},
+		{"G132", "Mutex locked without being unlocked on every path", NewMissingUnlock},
+		{"G133", "Write to a nil map", NewNilMapWrite},
+		{"G134", "context.Context which is not the first parameter", NewContextNotFirst},
+		{"G135", "Integer division by a variable not checked against zero", NewUncheckedDivisor},
+		{"G136", "Switch over an enum which is not exhaustive", NewExhaustiveSwitch},
+		{"G137", "Left shift by an unchecked count", NewUncheckedShift},
+		{"G138", "Cancel function of a context not called", NewLostCancel},
+		{"G139", "Database connection string with an inline password", NewDSNCredentials},
+		{"G140", "Result of append to a map value not stored back", NewLostMapAppend},
+		{"G141", "Error overwritten before being checked", NewErrOverwrite},
+		{"G142", "Memory reinterpreted through an unsafe.Pointer conversion", NewUnsafeCast},
+		{"G143", "Sensitive name compared after an ASCII case conversion", NewCaseConvertedComparison},
+		{"G144", "Map looked up twice with the same key (performance)", NewDoubleLookup},
+		{"G145", "Result of Write ignored", NewIgnoredWrite},
+		{"G146", "Range over a channel which is never closed", NewUnclosedChannelRange},
+		{"G147", "Variable shadowing a builtin function or an imported package", NewShadowedName},
+		{"G148", "time.Time compared with == or !=", NewTimeComparison},
+		{"G149", "Received context ignored and replaced by a new one", NewIgnoredContext},
 
 		// injection
 		{"G201", "SQL query construction using format string", NewSQLStrFormat},
@@ -94,12 +133,18 @@ func Generate(filters ...RuleFilter) RuleList {
 		{"G305", "File path traversal when extracting zip archive", NewArchive},
 		{"G306", "Poor file permissions used when writing to a file", NewWritePerms},
 		{"G307", "Unsafe defer call of a method returning an error", NewDeferredClosing},
+		{"G308", "Dropped error of a deferred Close, Flush or Commit", NewDeferredErrorDropped},
+		{"G309", "File path built by concatenation instead of filepath.Join", NewConcatenatedPath},
+		{"G310", "Key material written with permissions broader than 0600", NewKeyFilePerms},
 
 		// crypto
 		{"G401", "Detect the usage of DES, RC4, MD5 or SHA1", NewUsesWeakCryptography},
 		{"G402", "Look for bad TLS connection settings", NewIntermediateTLSCheck},
 		{"G403", "Ensure minimum RSA key length of 2048 bits", NewWeakKeyStrength},
 		{"G404", "Insecure random number source (rand)", NewWeakRandCheck},
+		{"G405", "Secrets compared in non-constant time", NewSecretComparison},
+		{"G406", "Ignored error of a crypto/rand read", NewUncheckedRandRead},
+		{"G407", "Nonce or identifier generated from a predictable source", NewPredictableNonce},
 
 		// blocklist
 		{"G501", "Import blocklist: crypto/md5", NewBlocklistedImportMD5},
@@ -107,9 +152,15 @@ func Generate(filters ...RuleFilter) RuleList {
 		{"G503", "Import blocklist: crypto/rc4", NewBlocklistedImportRC4},
 		{"G504", "Import blocklist: net/http/cgi", NewBlocklistedImportCGI},
 		{"G505", "Import blocklist: crypto/sha1", NewBlocklistedImportSHA1},
+		{"G506", "Import blocklist: io/ioutil", NewBlocklistedImportIoutil},
 
 		// memory safety
 		{"G601", "Implicit memory aliasing in RangeStmt", NewImplicitAliasing},
+		{"G602", "Slice appended to itself", NewSelfAppend},
+		{"G603", "Address of a loop variable escaping the iteration", NewLoopVariableAddress},
+		{"G604", "Loop variable captured by a function literal outliving the iteration", NewLoopVariableCapture},
+		{"G605", "Slice appended to while ranging over it", NewAppendWhileRanging},
+		{"G606", "Result of a big.Int method aliasing its receiver", NewBigIntAliasing},
 
 		// CosmosSDK Modules
 		{"G701", "Casting integers", sdk.NewIntegerCast},
@@ -117,6 +168,41 @@ func Generate(filters ...RuleFilter) RuleList {
 		{"G703", "Errors that don't result in rollback", sdk.NewErrorNotPropagated},
 		{"G704", "Strconv invalid bitSize and cast", sdk.NewStrconvIntBitSizeOverflow},
 		// {"G705", "Iterating over maps undeterministically", sdk.NewMapRangingCheck}, // TODO refine this rule and make it less noisy
+		{"G706", "Use of time.Sleep in state machine code", sdk.NewSleepInConsensus},
+		{"G707", "JSON decoded into generic types in state machine code", sdk.NewGenericJSONDecoding},
+		{"G708", "Arithmetic overflowing before a narrowing conversion", sdk.NewArithmeticBeforeCast},
+		{"G709", "Size passed to make truncated by a conversion of len or cap", sdk.NewTruncatedMakeSize},
+		{"G710", "Sorting state machine data without a total order", sdk.NewSortWithoutTieBreaker},
+		{"G711", "Floating point math in state machine code", sdk.NewFloatMathRefusal},
+		{"G712", "Maps built in a loop and serialized in iteration order", sdk.NewMapOrderSerialization},
+		{"G713", "Ignored errors when decoding Bech32 addresses", sdk.NewIgnoredAddressError},
+		{"G714", "Loops over user input without consuming gas", sdk.NewUnmeteredLoop},
+		{"G715", "Exported functions using the empty interface", sdk.NewEmptyInterfaceSignature},
+		{"G716", "Contexts stored in struct fields", sdk.NewContextInStruct},
+		{"G717", "Decimals constructed from floating point values", sdk.NewDecFromFloatRefusal},
+		{"G718", "Persisted types without serialization tags", sdk.NewMissingSerializationTag},
+		{"G719", "time.Time stored in persisted types", sdk.NewPersistedTime},
+		{"G720", "Output built in map iteration order", sdk.NewMapOrderBuilder},
+		{"G721", "String methods ranging over maps", sdk.NewMapRangingStringer},
+		{"G722", "Coins appended in a loop returned unsorted", sdk.NewUnsortedCoins},
+		{"G723", "Collections ordered randomly in the state machine", sdk.NewRandomizedOrdering},
+		{"G724", "Directory entries ranged over in file system order", sdk.NewUnsortedDirListing},
+		{"G725", "Gob encoding in the state machine", sdk.NewGobEncoding},
+		{"G726", "reflect.DeepEqual on types with an Equal method", sdk.NewDeepEqualWithEqual},
+		{"G727", "Integers parsed while ignoring the error", sdk.NewIgnoredParseError},
+		{"G728", "Logging in the loops of the state machine", sdk.NewLoggingInLoop},
+		{"G729", "Hardcoded network settings", sdk.NewHardcodedNetworkSetting},
+		{"G730", "Loop over a store iterator without consuming gas", sdk.NewUnmeteredIterator},
+		{"G731", "Store iterator not closed", sdk.NewUnclosedIterator},
+		{"G732", "Big numbers compared with == or !=", sdk.NewBigNumberComparison},
+		{"G733", "Events emitted while ranging over a map", sdk.NewEventsInMapOrder},
+		{"G734", "Panic in the validation of messages", sdk.NewPanicInValidation},
+		{"G735", "Registrations in init depending on the iteration order of a map", sdk.NewRegistrationInMapOrder},
+		{"G736", "Recursion on user provided data without a bound on its depth", sdk.NewUnboundedRecursion},
+		{"G737", "Less methods comparing a single field", sdk.NewPartialLess},
+		{"G738", "Store keys concatenating variable length components", sdk.NewUnprefixedStoreKey},
+		{"G739", "Monetary amounts held as floating point numbers", sdk.NewFloatAmount},
+		{"G740", "Message fields used before the message is validated", sdk.NewUseBeforeValidation},
 	}
 
 	ruleMap := make(map[string]RuleDefinition)
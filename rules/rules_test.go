@@ -187,6 +187,381 @@ var _ = Describe("gosec rules", func() {
 			runner("G601", testutils.SampleCodeG601)
 		})
 
+		It("should detect shadowing of the err variable", func() {
+			runner("G111", testutils.SampleCodeG111)
+		})
+
+		It("should detect time.Sleep in keepers and handlers", func() {
+			runner("G706", testutils.SampleCodeG706)
+		})
+
+		It("should detect value receivers losing mutations", func() {
+			runner("G112", testutils.SampleCodeG112)
+		})
+
+		It("should detect JSON decoded into generic types", func() {
+			runner("G707", testutils.SampleCodeG707)
+		})
+
+		It("should detect library code terminating the process", func() {
+			runner("G113", testutils.SampleCodeG113)
+		})
+
+		It("should detect secrets compared in non-constant time", func() {
+			runner("G405", testutils.SampleCodeG405)
+		})
+
+		It("should detect arithmetic overflowing before a narrowing conversion", func() {
+			runner("G708", testutils.SampleCodeG708)
+		})
+
+		It("should detect len or cap truncated when used as the size of make", func() {
+			runner("G709", testutils.SampleCodeG709)
+		})
+
+		It("should detect context values stored with keys of builtin types", func() {
+			runner("G114", testutils.SampleCodeG114)
+		})
+
+		It("should detect sorting on a single field in state machine code", func() {
+			runner("G710", testutils.SampleCodeG710)
+		})
+
+		It("should detect floating point math in state machine code", func() {
+			runner("G711", testutils.SampleCodeG711)
+		})
+
+		It("should detect maps serialized in iteration order", func() {
+			runner("G712", testutils.SampleCodeG712)
+		})
+
+		It("should detect ignored errors when decoding addresses", func() {
+			runner("G713", testutils.SampleCodeG713)
+		})
+
+		It("should detect loops over user input without consuming gas", func() {
+			runner("G714", testutils.SampleCodeG714)
+		})
+
+		It("should detect slices appended to themselves", func() {
+			runner("G602", testutils.SampleCodeG602)
+		})
+
+		It("should detect deferred calls on a receiver which is reassigned", func() {
+			runner("G115", testutils.SampleCodeG115)
+		})
+
+		It("should detect constant errors created inside loops", func() {
+			runner("G116", testutils.SampleCodeG116)
+		})
+
+		It("should detect constant regular expressions compiled inside functions", func() {
+			runner("G117", testutils.SampleCodeG117)
+		})
+
+		It("should detect deprecated or error prone standard library calls", func() {
+			runner("G118", testutils.SampleCodeG118)
+		})
+
+		It("should detect HTTP response bodies which are not closed", func() {
+			runner("G119", testutils.SampleCodeG119)
+		})
+
+		It("should detect ignored number of elements copied", func() {
+			runner("G120", testutils.SampleCodeG120)
+		})
+
+		It("should detect recover called outside of deferred functions", func() {
+			runner("G121", testutils.SampleCodeG121)
+		})
+
+		It("should detect slice bounds not checked against the length", func() {
+			runner("G122", testutils.SampleCodeG122)
+		})
+
+		It("should detect exported functions using the empty interface", func() {
+			runner("G715", testutils.SampleCodeG715)
+		})
+
+		It("should detect contexts stored in struct fields", func() {
+			runner("G716", testutils.SampleCodeG716)
+		})
+
+		It("should detect addresses of loop variables escaping the iteration", func() {
+			runner("G603", testutils.SampleCodeG603)
+		})
+
+		It("should detect decimals constructed from floating point values", func() {
+			runner("G717", testutils.SampleCodeG717)
+		})
+
+		It("should detect panics with values which are neither errors nor strings", func() {
+			runner("G123", testutils.SampleCodeG123)
+		})
+
+		It("should detect writes to the copy of a range element", func() {
+			runner("G124", testutils.SampleCodeG124)
+		})
+
+		It("should detect discarded results of functions without side effects", func() {
+			runner("G125", testutils.SampleCodeG125)
+		})
+
+		It("should detect hand-rolled absolute values of signed integers", func() {
+			runner("G126", testutils.SampleCodeG126)
+		})
+
+		It("should detect persisted types without serialization tags", func() {
+			runner("G718", testutils.SampleCodeG718)
+		})
+
+		It("should detect values holding a sync lock being copied", func() {
+			runner("G127", testutils.SampleCodeG127)
+		})
+
+		It("should detect channel operations ignoring the cancellation of the context", func() {
+			runner("G128", testutils.SampleCodeG128)
+		})
+
+		It("should detect blocklisted imports - io/ioutil", func() {
+			runner("G506", testutils.SampleCodeG506)
+		})
+
+		It("should detect time.Time fields in persisted types", func() {
+			runner("G719", testutils.SampleCodeG719)
+		})
+
+		It("should detect ignored errors of crypto/rand reads", func() {
+			runner("G406", testutils.SampleCodeG406)
+		})
+
+		It("should detect output built in map iteration order", func() {
+			runner("G720", testutils.SampleCodeG720)
+		})
+
+		It("should detect panics in init functions", func() {
+			runner("G129", testutils.SampleCodeG129)
+		})
+
+		It("should detect String methods ranging over maps", func() {
+			runner("G721", testutils.SampleCodeG721)
+		})
+
+		It("should detect coins appended in a loop returned unsorted", func() {
+			runner("G722", testutils.SampleCodeG722)
+		})
+
+		It("should detect appends whose result is lost", func() {
+			runner("G130", testutils.SampleCodeG130)
+		})
+
+		It("should detect collections ordered randomly in the state machine", func() {
+			runner("G723", testutils.SampleCodeG723)
+		})
+
+		It("should detect directory entries ranged over in file system order", func() {
+			runner("G724", testutils.SampleCodeG724)
+		})
+
+		It("should detect errors compared with sentinel errors", func() {
+			runner("G131", testutils.SampleCodeG131)
+		})
+
+		It("should detect gob encoding in the state machine", func() {
+			runner("G725", testutils.SampleCodeG725)
+		})
+
+		It("should detect reflect.DeepEqual on types with an Equal method", func() {
+			runner("G726", testutils.SampleCodeG726)
+		})
+
+		It("should detect loop variables captured by function literals outliving the iteration", func() {
+			runner("G604", testutils.SampleCodeG604)
+		})
+
+		It("should detect mutexes locked without being unlocked on every path", func() {
+			runner("G132", testutils.SampleCodeG132)
+		})
+
+		It("should detect writes to nil maps", func() {
+			runner("G133", testutils.SampleCodeG133)
+		})
+
+		It("should detect integers parsed while ignoring the error", func() {
+			runner("G727", testutils.SampleCodeG727)
+		})
+
+		It("should detect context.Context which is not the first parameter", func() {
+			runner("G134", testutils.SampleCodeG134)
+		})
+
+		It("should detect logging in the loops of the state machine", func() {
+			runner("G728", testutils.SampleCodeG728)
+		})
+
+		It("should detect integer divisions by variables not checked against zero", func() {
+			runner("G135", testutils.SampleCodeG135)
+		})
+
+		It("should detect slices appended to while ranging over them", func() {
+			runner("G605", testutils.SampleCodeG605)
+		})
+
+		It("should detect hardcoded network settings", func() {
+			runner("G729", testutils.SampleCodeG729)
+		})
+
+		It("should not detect hardcoded network settings in test files", func() {
+			analyzer = gosec.NewAnalyzer(config, true, logger)
+			analyzer.LoadRules(rules.Generate(rules.NewRuleFilter(false, "G729")).Builders())
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("keeper.go", `
+package keeper
+
+type Keeper struct {
+	chainID string
+}`)
+			pkg.AddFile("keeper_test.go", `
+package keeper
+
+import "testing"
+
+func TestKeeper(t *testing.T) {
+	k := Keeper{chainID: "testchain-1"}
+	if k.chainID == "" {
+		t.Fail()
+	}
+}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(metrics.NumFiles).Should(BeNumerically(">=", 2))
+			Expect(issues).Should(BeEmpty())
+		})
+
+		It("should detect dropped errors of deferred Close, Flush or Commit calls", func() {
+			runner("G308", testutils.SampleCodeG308)
+		})
+
+		It("should detect switches over enums which are not exhaustive", func() {
+			runner("G136", testutils.SampleCodeG136)
+		})
+
+		It("should detect results of big.Int methods aliasing their receiver", func() {
+			runner("G606", testutils.SampleCodeG606)
+		})
+
+		It("should detect left shifts by unchecked counts", func() {
+			runner("G137", testutils.SampleCodeG137)
+		})
+
+		It("should detect cancel functions of contexts which are not called", func() {
+			runner("G138", testutils.SampleCodeG138)
+		})
+
+		It("should detect file paths built by concatenation", func() {
+			runner("G309", testutils.SampleCodeG309)
+		})
+
+		It("should detect nonces generated from predictable sources", func() {
+			runner("G407", testutils.SampleCodeG407)
+		})
+
+		It("should detect connection strings with inline passwords", func() {
+			runner("G139", testutils.SampleCodeG139)
+		})
+
+		It("should detect key material written with broad permissions", func() {
+			runner("G310", testutils.SampleCodeG310)
+		})
+
+		It("should detect loops over store iterators without consuming gas", func() {
+			runner("G730", testutils.SampleCodeG730)
+		})
+
+		It("should detect store iterators which are not closed", func() {
+			runner("G731", testutils.SampleCodeG731)
+		})
+
+		It("should detect appends to map values which are lost", func() {
+			runner("G140", testutils.SampleCodeG140)
+		})
+
+		It("should detect errors overwritten before being checked", func() {
+			runner("G141", testutils.SampleCodeG141)
+		})
+
+		It("should detect big numbers compared with ==", func() {
+			runner("G732", testutils.SampleCodeG732)
+		})
+
+		It("should detect events emitted while ranging over maps", func() {
+			runner("G733", testutils.SampleCodeG733)
+		})
+
+		It("should detect panics in the validation of messages", func() {
+			runner("G734", testutils.SampleCodeG734)
+		})
+
+		It("should detect registrations in init depending on the order of a map", func() {
+			runner("G735", testutils.SampleCodeG735)
+		})
+
+		It("should detect memory reinterpreted through unsafe.Pointer conversions", func() {
+			runner("G142", testutils.SampleCodeG142)
+		})
+
+		It("should detect sensitive names compared after a case conversion", func() {
+			runner("G143", testutils.SampleCodeG143)
+		})
+
+		It("should detect unbounded recursion in handlers", func() {
+			runner("G736", testutils.SampleCodeG736)
+		})
+
+		It("should detect maps looked up twice with the same key", func() {
+			runner("G144", testutils.SampleCodeG144)
+		})
+
+		It("should detect Less methods comparing a single field", func() {
+			runner("G737", testutils.SampleCodeG737)
+		})
+
+		It("should detect ignored results of Write", func() {
+			runner("G145", testutils.SampleCodeG145)
+		})
+
+		It("should detect ranges over channels which are never closed", func() {
+			runner("G146", testutils.SampleCodeG146)
+		})
+
+		It("should detect variables shadowing builtins and imports", func() {
+			runner("G147", testutils.SampleCodeG147)
+		})
+
+		It("should detect time.Time compared with ==", func() {
+			runner("G148", testutils.SampleCodeG148)
+		})
+
+		It("should detect store keys concatenating variable length components", func() {
+			runner("G738", testutils.SampleCodeG738)
+		})
+
+		It("should detect monetary amounts held as floating point numbers", func() {
+			runner("G739", testutils.SampleCodeG739)
+		})
+
+		It("should detect message fields used before the message is validated", func() {
+			runner("G740", testutils.SampleCodeG740)
+		})
+
+		It("should detect received contexts replaced by new ones", func() {
+			runner("G149", testutils.SampleCodeG149)
+		})
+
 	})
 
 })
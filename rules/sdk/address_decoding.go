@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type ignoredAddressError struct {
+	gosec.MetaData
+	decoders map[string]bool
+}
+
+func (r *ignoredAddressError) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *ignoredAddressError) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.AssignStmt)
+	if !ok || len(stmt.Rhs) != 1 {
+		return nil, nil
+	}
+	call, ok := stmt.Rhs[0].(*ast.CallExpr)
+	if !ok || !r.decoders[calleeName(call)] {
+		return nil, nil
+	}
+	pos := returnsError(call, ctx)
+	if pos < 0 || pos >= len(stmt.Lhs) {
+		return nil, nil
+	}
+	if id, ok := stmt.Lhs[pos].(*ast.Ident); ok && id.Name == "_" {
+		return gosec.NewIssue(ctx, stmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// calleeName returns the name of the called function or method without its
+// package or receiver, e.g. AccAddressFromBech32 for sdk.AccAddressFromBech32(s).
+func calleeName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+	return ""
+}
+
+// NewIgnoredAddressError detects Bech32 addresses decoded while ignoring the error,
+// leaving an empty or partial address to be used afterwards. The decoders are
+// matched by name since their import path differs between SDK versions, and can
+// be configured as follows:
+//
+//	{"G713": {"functions": ["AccAddressFromBech32", "AddressFromHex"]}}
+func NewIgnoredAddressError(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	decoders := map[string]bool{
+		"AccAddressFromBech32":  true,
+		"ValAddressFromBech32":  true,
+		"ConsAddressFromBech32": true,
+		"GetFromBech32":         true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if functions, ok := settings["functions"].([]interface{}); ok {
+				decoders = make(map[string]bool)
+				for _, fn := range functions {
+					if name, ok := fn.(string); ok {
+						decoders[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &ignoredAddressError{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Error of the address decoding ignored, the decoded address may be empty",
+		},
+		decoders: decoders,
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type arithmeticBeforeCast struct {
+	gosec.MetaData
+}
+
+func (r *arithmeticBeforeCast) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags conversions such as int32(a*b) where the multiplication, addition
+// or shift is carried out in the wider type of a and b and then truncated.
+func (r *arithmeticBeforeCast) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, nil
+	}
+	destType, ok := integerConversion(call, ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	expr, ok := unparen(call.Args[0]).(*ast.BinaryExpr)
+	if !ok {
+		return nil, nil
+	}
+	switch expr.Op {
+	case token.MUL, token.ADD, token.SHL:
+	default:
+		return nil, nil
+	}
+	// Constant expressions are checked by the compiler.
+	if tv, ok := ctx.Info.Types[expr]; !ok || tv.Value != nil {
+		return nil, nil
+	}
+	srcType, ok := integerType(ctx.Info.TypeOf(expr))
+	if !ok || !canNarrowingOverflow(srcType.String(), destType.String()) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// integerConversion returns the target type if the call is a conversion to an integer type
+func integerConversion(call *ast.CallExpr, ctx *gosec.Context) (*types.Basic, bool) {
+	tv, ok := ctx.Info.Types[call.Fun]
+	if !ok || !tv.IsType() {
+		return nil, false
+	}
+	return integerType(tv.Type)
+}
+
+func integerType(t types.Type) (*types.Basic, bool) {
+	if t == nil {
+		return nil, false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return nil, false
+	}
+	return basic, true
+}
+
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.X
+	}
+}
+
+// canNarrowingOverflow returns true if converting from srcKind to destKind can truncate the value
+func canNarrowingOverflow(srcKind, destKind string) bool {
+	if srcKind == destKind {
+		return false
+	}
+	switch {
+	case hasAnyPrefix(srcKind, "uint") && hasAnyPrefix(destKind, "uint"):
+		return canBothUintsOverflow(srcKind, destKind)
+	case hasAnyPrefix(srcKind, "int") && hasAnyPrefix(destKind, "int"):
+		return canBothIntToIntOverflow(srcKind, destKind)
+	}
+	// Changes of signedness are covered by the integer conversion rule.
+	return false
+}
+
+// NewArithmeticBeforeCast detects arithmetic which can overflow before its result
+// is narrowed by an integer conversion.
+func NewArithmeticBeforeCast(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &arithmeticBeforeCast{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.Medium,
+			What:       "Arithmetic result truncated by a narrowing integer conversion, use checked arithmetic",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type bigNumberComparison struct {
+	gosec.MetaData
+	numberTypes map[string]bool
+}
+
+func (r *bigNumberComparison) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *bigNumberComparison) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	binary, ok := n.(*ast.BinaryExpr)
+	if !ok || (binary.Op != token.EQL && binary.Op != token.NEQ) {
+		return nil, nil
+	}
+	// comparing the pointers with nil is fine
+	for _, operand := range []ast.Expr{binary.X, binary.Y} {
+		if tv, ok := ctx.Info.Types[operand]; ok && tv.IsNil() {
+			return nil, nil
+		}
+	}
+	if r.isBigNumber(ctx.Info.TypeOf(binary.X)) || r.isBigNumber(ctx.Info.TypeOf(binary.Y)) {
+		return gosec.NewIssue(ctx, binary, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isBigNumber returns true if the type, or the type it points to, is one of the
+// configured big number types
+func (r *bigNumberComparison) isBigNumber(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && r.numberTypes[types.TypeString(named, nil)]
+}
+
+// NewBigNumberComparison detects big numbers such as sdk.Dec, math.Int or
+// *big.Int compared with == or !=, which compares their internal representation
+// or their address instead of their value. Equal or Cmp should be used instead.
+// The types are matched by their full name and can be configured:
+//
+//	{"G732": {"types": ["cosmossdk.io/math.Int", "math/big.Int"]}}
+func NewBigNumberComparison(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	numberTypes := map[string]bool{
+		"math/big.Int":                           true,
+		"math/big.Float":                         true,
+		"math/big.Rat":                           true,
+		"github.com/cosmos/cosmos-sdk/types.Dec": true,
+		"github.com/cosmos/cosmos-sdk/types.Int": true,
+		"cosmossdk.io/math.Int":                  true,
+		"cosmossdk.io/math.Uint":                 true,
+		"cosmossdk.io/math.LegacyDec":            true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				numberTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						numberTypes[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &bigNumberComparison{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Big numbers compared with == or !=, use Equal or Cmp to compare their values",
+		},
+		numberTypes: numberTypes,
+	}, []ast.Node{(*ast.BinaryExpr)(nil)}
+}
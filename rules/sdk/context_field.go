@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type contextInStruct struct {
+	gosec.MetaData
+	contextTypes map[string]bool
+}
+
+func (r *contextInStruct) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *contextInStruct) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	structType, ok := n.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return nil, nil
+	}
+	for _, field := range structType.Fields.List {
+		t := ctx.Info.TypeOf(field.Type)
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		if t != nil && r.contextTypes[types.TypeString(t, nil)] {
+			return gosec.NewIssue(ctx, field, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewContextInStruct detects contexts stored in struct fields instead of being
+// passed along as the first parameter, which keeps request scoped state alive
+// beyond the request. The context types are matched by their full name and can
+// be configured as follows:
+//
+//	{"G716": {"types": ["context.Context", "github.com/cosmos/cosmos-sdk/types.Context"]}}
+func NewContextInStruct(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	contextTypes := map[string]bool{
+		"context.Context": true,
+		"github.com/cosmos/cosmos-sdk/types.Context": true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				contextTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						contextTypes[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &contextInStruct{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Context stored in a struct field, pass it as a parameter instead",
+		},
+		contextTypes: contextTypes,
+	}, []ast.Node{(*ast.StructType)(nil)}
+}
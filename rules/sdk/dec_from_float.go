@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type decFromFloatRefusal struct {
+	gosec.MetaData
+	constructors map[string]bool
+}
+
+func (r *decFromFloatRefusal) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *decFromFloatRefusal) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || !r.constructors[calleeName(call)] {
+		return nil, nil
+	}
+	for _, arg := range call.Args {
+		if isFloatSource(arg, ctx) {
+			return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isFloatSource returns true for floating point values, including the ones
+// converted to an integer before being passed on, e.g. int64(f * 100).
+func isFloatSource(expr ast.Expr, ctx *gosec.Context) bool {
+	expr = unparen(expr)
+	if basic, ok := ctx.Info.TypeOf(expr).(*types.Basic); ok && basic.Info()&types.IsFloat != 0 {
+		return true
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	if tv, ok := ctx.Info.Types[call.Fun]; !ok || !tv.IsType() {
+		return false
+	}
+	return isFloatSource(call.Args[0], ctx)
+}
+
+// NewDecFromFloatRefusal detects decimals constructed from floating point values,
+// which brings back the rounding and platform differences the decimals avoid. The
+// constructors are matched by name since they moved between SDK versions, and can
+// be configured as follows:
+//
+//	{"G717": {"functions": ["NewDecFromFloat", "LegacyNewDec"]}}
+func NewDecFromFloatRefusal(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	constructors := map[string]bool{
+		"NewDecFromFloat":      true,
+		"MustNewDecFromFloat":  true,
+		"NewDec":               true,
+		"NewDecWithPrec":       true,
+		"LegacyNewDec":         true,
+		"LegacyNewDecWithPrec": true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if functions, ok := settings["functions"].([]interface{}); ok {
+				constructors = make(map[string]bool)
+				for _, fn := range functions {
+					if name, ok := fn.(string); ok {
+						constructors[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &decFromFloatRefusal{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Decimal constructed from a floating point value, construct it from a string or an integer instead",
+		},
+		constructors: constructors,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type deepEqualWithEqual struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *deepEqualWithEqual) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *deepEqualWithEqual) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, ctx, false)
+	if call == nil || len(call.Args) != 2 {
+		return nil, nil
+	}
+	for _, arg := range call.Args {
+		t := ctx.Info.TypeOf(arg)
+		if t == nil || !hasEqualMethod(t) {
+			continue
+		}
+		what := fmt.Sprintf("Use the Equal method of %s instead of reflect.DeepEqual", types.TypeString(t, types.RelativeTo(ctx.Pkg)))
+		return gosec.NewIssue(ctx, call, r.ID(), what, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// hasEqualMethod returns true if the type, or a pointer to it, has a method
+// Equal taking a single argument and returning a bool.
+func hasEqualMethod(t types.Type) bool {
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "Equal")
+	method, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig, ok := method.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	basic, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && basic.Kind() == types.Bool
+}
+
+// NewDeepEqualWithEqual detects reflect.DeepEqual comparing values whose type has
+// an Equal method. DeepEqual compares the internal representation, e.g. of big
+// integers or decimals, instead of the values, and is slow.
+func NewDeepEqualWithEqual(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("reflect", "DeepEqual")
+	return &deepEqualWithEqual{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Use the Equal method instead of reflect.DeepEqual",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
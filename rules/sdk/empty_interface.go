@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type emptyInterfaceSignature struct {
+	gosec.MetaData
+	allowVariadic bool
+	scope         *moduleScope
+}
+
+func (r *emptyInterfaceSignature) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *emptyInterfaceSignature) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || !fn.Name.IsExported() {
+		return nil, nil
+	}
+	obj, ok := ctx.Info.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return nil, nil
+	}
+	sig, ok := obj.Type().(*types.Signature)
+	if !ok || !r.hasEmptyInterface(sig) || !r.scope.contains(fn, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, fn, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+func (r *emptyInterfaceSignature) hasEmptyInterface(sig *types.Signature) bool {
+	params := sig.Params()
+	for i := 0; i < params.Len(); i++ {
+		t := params.At(i).Type()
+		if sig.Variadic() && i == params.Len()-1 {
+			if r.allowVariadic {
+				continue
+			}
+			if slice, ok := t.(*types.Slice); ok {
+				t = slice.Elem()
+			}
+		}
+		if isEmptyInterface(t) {
+			return true
+		}
+	}
+	results := sig.Results()
+	for i := 0; i < results.Len(); i++ {
+		if isEmptyInterface(results.At(i).Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyInterface returns true for interface{} and any. Named interfaces and
+// type parameters constrained by any are not reported. Since any is an alias,
+// represented by its own type with the recent toolchains, the underlying type
+// of the types which are not named is checked.
+func isEmptyInterface(t types.Type) bool {
+	switch t.(type) {
+	case *types.Named, *types.TypeParam:
+		return false
+	}
+	iface, ok := t.Underlying().(*types.Interface)
+	return ok && iface.Empty()
+}
+
+// NewEmptyInterfaceSignature detects exported functions which accept or return
+// the empty interface, giving up the type safety of the module API. Variadic
+// parameters such as the arguments of fmt-style functions can be allowed:
+//
+//	{"G715": {"allow_variadic": true}}
+func NewEmptyInterfaceSignature(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	allowVariadic := false
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if allow, ok := settings["allow_variadic"].(bool); ok {
+				allowVariadic = allow
+			}
+		}
+	}
+	return &emptyInterfaceSignature{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Exported function uses the empty interface, prefer concrete types",
+		},
+		allowVariadic: allowVariadic,
+		scope:         newModuleScope(id, conf),
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
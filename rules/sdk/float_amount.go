@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultAmountPattern matches the names of the fields and variables holding
+// monetary amounts
+const defaultAmountPattern = `(?i)(amount|balance|price|fee|coin|supply|stake|reward|deposit)s?$`
+
+type floatAmount struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+}
+
+func (r *floatAmount) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *floatAmount) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.StructType:
+		if node.Fields == nil {
+			return nil, nil
+		}
+		for _, field := range node.Fields.List {
+			if !isFloatAmountType(ctx.Info.TypeOf(field.Type)) {
+				continue
+			}
+			for _, name := range field.Names {
+				if r.pattern.MatchString(name.Name) {
+					return gosec.NewIssue(ctx, field, r.ID(), r.What, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		if len(node.Rhs) != 1 || len(node.Lhs) == 0 {
+			return nil, nil
+		}
+		if _, matches := gosec.MatchCallByPackage(node.Rhs[0], ctx, "strconv", "ParseFloat"); !matches {
+			return nil, nil
+		}
+		if ident, ok := node.Lhs[0].(*ast.Ident); ok && r.pattern.MatchString(ident.Name) {
+			return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isFloatAmountType returns true for the floating point types and json.Number,
+// which is decoded and usually converted as a float.
+func isFloatAmountType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if types.TypeString(t, nil) == "encoding/json.Number" {
+		return true
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsFloat != 0
+}
+
+// NewFloatAmount detects monetary amounts held in floating point fields or
+// json.Number fields, or parsed with strconv.ParseFloat. Floats lose precision
+// on large amounts and round differently between platforms, and the amounts
+// should use integer or decimal types such as math.Int or math.LegacyDec. The
+// fields and variables are matched by name with a pattern which can be
+// configured:
+//
+//	{"G739": {"pattern": "(?i)(amount|price)$"}}
+func NewFloatAmount(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultAmountPattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultAmountPattern)
+	}
+	return &floatAmount{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Monetary amount held as a floating point number, use an integer or decimal type instead",
+		},
+		pattern: re,
+	}, []ast.Node{(*ast.StructType)(nil), (*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type floatMathRefusal struct {
+	gosec.MetaData
+	calls gosec.CallList
+	scope *moduleScope
+}
+
+func (r *floatMathRefusal) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *floatMathRefusal) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	if call := r.calls.ContainsPkgCallExpr(n, ctx, false); call != nil && r.scope.contains(call, ctx) {
+		return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewFloatMathRefusal detects the floating point functions of the math package in
+// state machine code, whose results are not guaranteed to match across platforms.
+// The integer constants such as math.MaxInt64 are not calls and remain allowed.
+func NewFloatMathRefusal(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("math",
+		"Abs", "Acos", "Acosh", "Asin", "Asinh", "Atan", "Atan2", "Atanh",
+		"Cbrt", "Ceil", "Copysign", "Cos", "Cosh", "Dim", "Erf", "Erfc",
+		"Erfcinv", "Erfinv", "Exp", "Exp2", "Expm1", "FMA", "Floor", "Frexp",
+		"Gamma", "Hypot", "J0", "J1", "Jn", "Ldexp", "Lgamma", "Log",
+		"Log10", "Log1p", "Log2", "Logb", "Max", "Min", "Mod", "Modf",
+		"Nextafter", "Pow", "Pow10", "Remainder", "Round", "RoundToEven", "Sin", "Sincos",
+		"Sinh", "Sqrt", "Tan", "Tanh", "Trunc", "Y0", "Y1", "Yn",
+	)
+	return &floatMathRefusal{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Floating point math in state machine code, use sdk.Dec operations instead",
+		},
+		calls: calls,
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type gobEncoding struct {
+	*blocklistedImport
+	calls gosec.CallList
+	scope *moduleScope
+}
+
+func (r *gobEncoding) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
+	if !r.scope.contains(n, c) {
+		return nil, nil
+	}
+	if _, ok := n.(*ast.ImportSpec); ok {
+		return r.blocklistedImport.Match(n, c)
+	}
+	if call := r.calls.ContainsPkgCallExpr(n, c, false); call != nil {
+		return gosec.NewIssue(c, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewGobEncoding fails if encoding/gob is imported or used in the state machine.
+// The gob encoding is not guaranteed to be stable across Go versions and should
+// not be used for the consensus state, protobuf should be used instead.
+func NewGobEncoding(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	rule, _ := NewBlocklistedImports(id, conf, map[string]string{
+		"encoding/gob": "Blocklisted import encoding/gob: encoding not deterministic across Go versions, use protobuf",
+	})
+	imports := rule.(*blocklistedImport)
+	imports.Severity = gosec.High
+	imports.What = "Gob encoding used in the state machine, use protobuf instead"
+
+	calls := gosec.NewCallList()
+	calls.AddAll("encoding/gob", "NewEncoder", "NewDecoder")
+	return &gobEncoding{
+		blocklistedImport: imports,
+		calls:             calls,
+		scope:             newModuleScope(id, conf),
+	}, []ast.Node{(*ast.ImportSpec)(nil), (*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultNetworkSettingPattern matches the snake case names of chain IDs, peers
+// and ports, whole words only so that e.g. proceeds or transport do not match
+const defaultNetworkSettingPattern = `(?i)((^|_)(chain_?id|seeds?|peers|(listen|rpc|grpc|p2p|api)?port)$|(^|_)bootstrap(_|$))`
+
+type hardcodedNetworkSetting struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+	scope   *moduleScope
+}
+
+func (r *hardcodedNetworkSetting) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *hardcodedNetworkSetting) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	var names, values []ast.Expr
+	switch node := n.(type) {
+	case *ast.AssignStmt:
+		names, values = node.Lhs, node.Rhs
+	case *ast.ValueSpec:
+		for _, name := range node.Names {
+			names = append(names, name)
+		}
+		values = node.Values
+	case *ast.KeyValueExpr:
+		names, values = []ast.Expr{node.Key}, []ast.Expr{node.Value}
+	default:
+		return nil, nil
+	}
+	if len(names) != len(values) {
+		return nil, nil
+	}
+	for i, name := range names {
+		var ident *ast.Ident
+		switch expr := name.(type) {
+		case *ast.Ident:
+			ident = expr
+		case *ast.SelectorExpr:
+			ident = expr.Sel
+		}
+		if ident == nil || !r.pattern.MatchString(gosec.SnakeCase(ident.Name)) || !isSettingLiteral(values[i]) {
+			continue
+		}
+		if !r.scope.contains(n, ctx) {
+			return nil, nil
+		}
+		return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isSettingLiteral returns true for the non-empty string and non-zero integer
+// literals, the zero values being used as unset defaults.
+func isSettingLiteral(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return false
+	}
+	switch lit.Kind {
+	case token.STRING:
+		return lit.Value != `""` && lit.Value != "``"
+	case token.INT:
+		return lit.Value != "0"
+	}
+	return false
+}
+
+// NewHardcodedNetworkSetting detects chain IDs, bootstrap peers and ports which
+// are hardcoded in the modules instead of being configured, tying them to a given
+// network. This is a heuristic based on the names the literals are assigned to,
+// matched in snake case, e.g. rpcPort as rpc_port. The pattern can be configured
+// along with the scope of the rule:
+//
+//	{"G729": {"pattern": "(^|_)(chain_id|seeds)$", "scope": "(?i)^keeper$"}}
+func NewHardcodedNetworkSetting(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultNetworkSettingPattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultNetworkSettingPattern)
+	}
+	return &hardcodedNetworkSetting{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Hardcoded network setting, e.g. a chain ID, peer or port, which should be configured",
+		},
+		pattern: re,
+		scope:   newModuleScope(id, conf),
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil), (*ast.KeyValueExpr)(nil)}
+}
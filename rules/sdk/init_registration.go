@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type registrationInMapOrder struct {
+	gosec.MetaData
+	functions map[string]bool
+}
+
+func (r *registrationInMapOrder) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the registrations made by the init functions while ranging over a
+// map, which register in a different order on every run.
+func (r *registrationInMapOrder) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Recv != nil || fn.Name.Name != "init" || fn.Body == nil {
+		return nil, nil
+	}
+	var issue *gosec.Issue
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		rangeStmt, ok := node.(*ast.RangeStmt)
+		if !ok || issue != nil {
+			return issue == nil
+		}
+		typ := ctx.Info.TypeOf(rangeStmt.X)
+		if typ == nil {
+			return true
+		}
+		if _, ok := typ.Underlying().(*types.Map); !ok {
+			return true
+		}
+		ast.Inspect(rangeStmt.Body, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok && issue == nil && r.functions[calleeName(call)] {
+				issue = gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence)
+			}
+			return issue == nil
+		})
+		return issue == nil
+	})
+	return issue, nil
+}
+
+// NewRegistrationInMapOrder detects init functions registering codecs, types or
+// routes into global registries while ranging over a map. The registrations
+// then happen in a different order on every run, and so do the type URLs, codec
+// prefixes or route precedences depending on it. This is a conservative
+// heuristic, and the registration functions can be configured:
+//
+//	{"G735": {"functions": ["RegisterConcrete", "RegisterInterface", "RegisterImplementations"]}}
+func NewRegistrationInMapOrder(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	functions := map[string]bool{
+		"Register":                true,
+		"RegisterConcrete":        true,
+		"RegisterInterface":       true,
+		"RegisterImplementations": true,
+		"RegisterType":            true,
+		"RegisterName":            true,
+		"Handle":                  true,
+		"HandleFunc":              true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["functions"].([]interface{}); ok {
+				functions = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						functions[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &registrationInMapOrder{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Registration in an init function depending on the iteration order of a map",
+		},
+		functions: functions,
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
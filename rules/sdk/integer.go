@@ -47,12 +47,6 @@ func hasAnyPrefix(src string, prefixes ...string) bool {
 // TODO: restrict it to just the possible bit-sizes for X (unspecified, 8, 16, 32, 64)
 // TODO: check if y's bit-size is greater than X
 func (i *integerOverflowCheck) Match(node ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
-	// ignore if it's protobuf
-	fileName := ctx.FileSet.File(node.Pos()).Name()
-	if strings.HasSuffix(fileName, ".pb.go") {
-		return nil, nil
-	}
-
 	switch n := node.(type) {
 	case *ast.CallExpr:
 		fun, ok := n.Fun.(*ast.Ident)
@@ -103,14 +97,7 @@ func (i *integerOverflowCheck) Match(node ast.Node, ctx *gosec.Context) (*gosec.
 				break
 			}
 
-			// Please see the rules for determining if *int*(len(...)) can overflow
-			// as per: https://github.com/cosmos/gosec/issues/54
-			lenCanOverflow := canLenOverflow64
-			if is32Bit {
-				lenCanOverflow = canLenOverflow32
-			}
-
-			if lenCanOverflow(fun.Name) {
+			if canLenOverflow(fun.Name) {
 				return gosec.NewIssue(ctx, n, i.ID(), i.What, i.Severity, i.Confidence), nil
 			}
 			return nil, nil
@@ -152,6 +139,17 @@ func NewIntegerCast(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	}, []ast.Node{(*ast.FuncDecl)(nil), (*ast.AssignStmt)(nil), (*ast.CallExpr)(nil)}
 }
 
+// canLenOverflow returns true if the result of len(...) or cap(...) can overflow
+// when converted to destKind on the current architecture.
+// Please see the rules for determining if *int*(len(...)) can overflow
+// as per: https://github.com/cosmos/gosec/issues/54
+func canLenOverflow(destKind string) bool {
+	if is32Bit {
+		return canLenOverflow32(destKind)
+	}
+	return canLenOverflow64(destKind)
+}
+
 // Please see the rules at https://github.com/cosmos/gosec/issues/54
 func canLenOverflow64(destKind string) bool {
 	switch destKind {
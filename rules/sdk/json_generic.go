@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type genericJSONDecoding struct {
+	gosec.MetaData
+	// decoders maps the functions to the position of their target argument
+	decoders map[string]int
+	scope    *moduleScope
+}
+
+func (r *genericJSONDecoding) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *genericJSONDecoding) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	name, ok := gosec.GetCallFullName(n, ctx)
+	if !ok {
+		return nil, nil
+	}
+	pos, ok := r.decoders[name]
+	if !ok {
+		return nil, nil
+	}
+	call := n.(*ast.CallExpr)
+	if pos >= len(call.Args) || !isGenericTarget(ctx.Info.TypeOf(call.Args[pos])) || !r.scope.contains(n, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isGenericTarget returns true for interface{} and map[string]interface{} once
+// all the pointer indirections were removed.
+func isGenericTarget(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	for {
+		ptr, ok := t.Underlying().(*types.Pointer)
+		if !ok {
+			break
+		}
+		t = ptr.Elem()
+	}
+	switch typ := t.Underlying().(type) {
+	case *types.Interface:
+		return typ.Empty()
+	case *types.Map:
+		if key, ok := typ.Key().Underlying().(*types.Basic); !ok || key.Kind() != types.String {
+			return false
+		}
+		elem, ok := typ.Elem().Underlying().(*types.Interface)
+		return ok && elem.Empty()
+	}
+	return false
+}
+
+// NewGenericJSONDecoding detects JSON being decoded into interface{} or map[string]interface{}
+// in state machine code, where numbers become floats and the shape of the data is unchecked.
+func NewGenericJSONDecoding(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &genericJSONDecoding{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "JSON decoded into a generic interface{} or map, use a concrete struct or a canonical codec",
+		},
+		decoders: map[string]int{
+			"encoding/json.Unmarshal":         1,
+			"(*encoding/json.Decoder).Decode": 0,
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
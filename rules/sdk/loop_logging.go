@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type loggingInLoop struct {
+	gosec.MetaData
+	scope       *moduleScope
+	loggerTypes map[string]bool
+	methods     map[string]bool
+}
+
+func (r *loggingInLoop) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *loggingInLoop) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !r.methods[sel.Sel.Name] || !r.isLogger(ctx.Info.TypeOf(sel.X)) {
+		return nil, nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, call.Pos(), call.End())
+	for _, node := range path {
+		switch loop := node.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return nil, nil
+		case *ast.ForStmt:
+			if !within(call, loop.Body) {
+				continue
+			}
+		case *ast.RangeStmt:
+			if !within(call, loop.Body) {
+				continue
+			}
+		default:
+			continue
+		}
+		if !r.scope.contains(call, ctx) {
+			return nil, nil
+		}
+		return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+func within(n ast.Node, block *ast.BlockStmt) bool {
+	return n.Pos() >= block.Pos() && n.End() <= block.End()
+}
+
+// isLogger returns true if the type, or the type it points to, is one of the
+// configured logger types, matched by name since the loggers of the SDK and
+// CometBFT moved between packages.
+func (r *loggingInLoop) isLogger(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && r.loggerTypes[named.Obj().Name()]
+}
+
+// NewLoggingInLoop detects logging in the loops of the state machine, which is
+// run by every node for every block and slows down the chain as the iterated
+// collections grow. The loops should aggregate what they log in a single call
+// after the loop. The logger types and methods can be configured:
+//
+//	{"G728": {"types": ["Logger"], "methods": ["Info", "Debug"]}}
+func NewLoggingInLoop(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	loggerTypes := map[string]bool{"Logger": true}
+	methods := map[string]bool{"Debug": true, "Info": true, "Warn": true, "Error": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				loggerTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						loggerTypes[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &loggingInLoop{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Medium,
+			What:       "Logging in a loop of the state machine, log once after the loop instead",
+		},
+		scope:       newModuleScope(id, conf),
+		loggerTypes: loggerTypes,
+		methods:     methods,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type truncatedMakeSize struct {
+	gosec.MetaData
+}
+
+func (r *truncatedMakeSize) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags make([]T, int32(len(src))) and similar, where the size derived
+// from another collection is truncated before being allocated.
+func (r *truncatedMakeSize) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || !isBuiltin(call.Fun, ctx, "make") {
+		return nil, nil
+	}
+	// The first argument is the type, the others are the length and capacity.
+	for _, arg := range call.Args[1:] {
+		conv, ok := unparen(arg).(*ast.CallExpr)
+		if !ok || len(conv.Args) != 1 {
+			continue
+		}
+		destType, ok := integerConversion(conv, ctx)
+		if !ok {
+			continue
+		}
+		size, ok := unparen(conv.Args[0]).(*ast.CallExpr)
+		if !ok || !(isBuiltin(size.Fun, ctx, "len") || isBuiltin(size.Fun, ctx, "cap")) {
+			continue
+		}
+		if canLenOverflow(destType.Name()) {
+			return gosec.NewIssue(ctx, conv, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isBuiltin returns true if the expression refers to the named builtin function
+func isBuiltin(expr ast.Expr, ctx *gosec.Context, name string) bool {
+	ident, ok := unparen(expr).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	builtin, ok := ctx.Info.Uses[ident].(*types.Builtin)
+	return ok && builtin.Name() == name
+}
+
+// NewTruncatedMakeSize detects len or cap results converted to a smaller integer
+// type when used as the size of make.
+func NewTruncatedMakeSize(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &truncatedMakeSize{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Size passed to make truncated by a narrowing conversion of len or cap",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type mapOrderBuilder struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *mapOrderBuilder) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the strings.Builder and bytes.Buffer written while ranging over a
+// map whose content is read once the loop is done.
+func (r *mapOrderBuilder) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := typeOfUnderlying(rangeStmt.X, ctx).(*types.Map); !ok {
+		return nil, nil
+	}
+
+	builders := make(map[types.Object]bool)
+	ast.Inspect(rangeStmt.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if fun, ok := call.Fun.(*ast.SelectorExpr); ok {
+			switch fun.Sel.Name {
+			case "Write", "WriteString", "WriteByte", "WriteRune":
+				if obj := builderObject(fun.X, ctx); obj != nil {
+					builders[obj] = true
+				}
+			}
+		}
+		// fmt.Fprintf(&b, ...) and the like
+		if len(call.Args) > 0 {
+			if obj := builderObject(call.Args[0], ctx); obj != nil {
+				builders[obj] = true
+			}
+		}
+		return true
+	})
+	if len(builders) == 0 {
+		return nil, nil
+	}
+
+	fn := gosec.GetEnclosingFuncDecl(rangeStmt, ctx)
+	if fn == nil || fn.Body == nil || !r.scope.contains(rangeStmt, ctx) {
+		return nil, nil
+	}
+	used := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || call.Pos() < rangeStmt.End() {
+			return !used
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && (sel.Sel.Name == "String" || sel.Sel.Name == "Bytes") {
+			if obj := builderObject(sel.X, ctx); obj != nil && builders[obj] {
+				used = true
+			}
+		}
+		return !used
+	})
+	if !used {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+func typeOfUnderlying(expr ast.Expr, ctx *gosec.Context) types.Type {
+	if t := ctx.Info.TypeOf(expr); t != nil {
+		return t.Underlying()
+	}
+	return nil
+}
+
+// builderObject returns the variable behind b or &b when it is a strings.Builder
+// or a bytes.Buffer, or a pointer to one of them.
+func builderObject(expr ast.Expr, ctx *gosec.Context) types.Object {
+	if unary, ok := unparen(expr).(*ast.UnaryExpr); ok {
+		expr = unary.X
+	}
+	ident, ok := unparen(expr).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	if obj == nil {
+		return nil
+	}
+	t := obj.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	switch types.TypeString(t, nil) {
+	case "strings.Builder", "bytes.Buffer":
+		return obj
+	}
+	return nil
+}
+
+// NewMapOrderBuilder detects text or bytes built while ranging over a map, whose
+// content then depends on the random iteration order. The keys should be sorted
+// before building the output.
+func NewMapOrderBuilder(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &mapOrderBuilder{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Output built in map iteration order, range over the sorted keys instead",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
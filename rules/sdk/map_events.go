@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type eventsInMapOrder struct {
+	gosec.MetaData
+	emitterTypes map[string]bool
+	methods      map[string]bool
+	scope        *moduleScope
+}
+
+func (r *eventsInMapOrder) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the range loops over a map whose body emits events, the events
+// being emitted in the random iteration order of the map.
+func (r *eventsInMapOrder) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok {
+		return nil, nil
+	}
+	typ := ctx.Info.TypeOf(rangeStmt.X)
+	if typ == nil {
+		return nil, nil
+	}
+	if _, ok := typ.Underlying().(*types.Map); !ok {
+		return nil, nil
+	}
+
+	var emit *ast.CallExpr
+	ast.Inspect(rangeStmt.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || emit != nil {
+			return emit == nil
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && r.methods[sel.Sel.Name] && r.isEmitter(ctx.Info.TypeOf(sel.X)) {
+			emit = call
+		}
+		return emit == nil
+	})
+	if emit == nil || !r.scope.contains(rangeStmt, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, emit, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isEmitter returns true if the type, or the type it points to, is one of the
+// configured event emitter types, matched by name since the event manager moved
+// between packages.
+func (r *eventsInMapOrder) isEmitter(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && r.emitterTypes[named.Obj().Name()]
+}
+
+// NewEventsInMapOrder detects events emitted while ranging over a map in the
+// state machine. The events are then emitted in the random iteration order of
+// the map, which differs between the nodes and breaks the indexers and clients
+// relying on their order. The keys of the map should be sorted first. The
+// emitter types and methods can be configured along with the scope:
+//
+//	{"G733": {"types": ["EventManager"], "methods": ["EmitEvent", "EmitTypedEvent"]}}
+func NewEventsInMapOrder(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	emitterTypes := map[string]bool{"EventManager": true}
+	methods := map[string]bool{"EmitEvent": true, "EmitEvents": true, "EmitTypedEvent": true, "EmitTypedEvents": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				emitterTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						emitterTypes[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &eventsInMapOrder{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Events emitted while ranging over a map, sort the keys to emit them in a deterministic order",
+		},
+		emitterTypes: emitterTypes,
+		methods:      methods,
+		scope:        newModuleScope(id, conf),
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type mapOrderSerialization struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *mapOrderSerialization) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags functions which fill a map in a loop and later serialize or hash
+// it by ranging over the map, which visits the entries in a random order.
+func (r *mapOrderSerialization) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil {
+		return nil, nil
+	}
+
+	// Maps written in a loop along with the position of their first write
+	built := make(map[types.Object]token.Pos)
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch loop := node.(type) {
+		case *ast.ForStmt:
+			collectMapWrites(loop.Body, ctx, built)
+		case *ast.RangeStmt:
+			collectMapWrites(loop.Body, ctx, built)
+		}
+		return true
+	})
+	if len(built) == 0 {
+		return nil, nil
+	}
+
+	var issue *gosec.Issue
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		if issue != nil {
+			return false
+		}
+		rangeStmt, ok := node.(*ast.RangeStmt)
+		if !ok {
+			return true
+		}
+		ident, ok := unparen(rangeStmt.X).(*ast.Ident)
+		if !ok {
+			return true
+		}
+		pos, ok := built[ctx.Info.ObjectOf(ident)]
+		if !ok || rangeStmt.Pos() < pos {
+			return true
+		}
+		if serializes(rangeStmt.Body) && r.scope.contains(rangeStmt, ctx) {
+			issue = gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence)
+		}
+		return true
+	})
+	return issue, nil
+}
+
+// collectMapWrites records the maps assigned with m[k] = v in the given block
+func collectMapWrites(body *ast.BlockStmt, ctx *gosec.Context, built map[types.Object]token.Pos) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for _, lhs := range assign.Lhs {
+			index, ok := lhs.(*ast.IndexExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := unparen(index.X).(*ast.Ident)
+			if !ok {
+				continue
+			}
+			obj := ctx.Info.ObjectOf(ident)
+			if obj == nil {
+				continue
+			}
+			if _, ok := obj.Type().Underlying().(*types.Map); !ok {
+				continue
+			}
+			if pos, ok := built[obj]; !ok || assign.Pos() < pos {
+				built[obj] = assign.Pos()
+			}
+		}
+		return true
+	})
+}
+
+// serializes returns true if the block writes, encodes or marshals data
+func serializes(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+		var name string
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			name = fun.Sel.Name
+		case *ast.Ident:
+			name = fun.Name
+		}
+		if hasAnyPrefix(name, "Write", "Encode", "Marshal") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewMapOrderSerialization detects maps built in a loop and then serialized or
+// hashed in their iteration order instead of the order of their sorted keys.
+func NewMapOrderSerialization(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &mapOrderSerialization{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Map serialized in iteration order, sort the keys before writing the entries",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
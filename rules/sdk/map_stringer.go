@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type mapRangingStringer struct {
+	gosec.MetaData
+}
+
+func (r *mapRangingStringer) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *mapRangingStringer) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil || !isStringMethod(fn, ctx) {
+		return nil, nil
+	}
+	var issue *gosec.Issue
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		if issue != nil {
+			return false
+		}
+		if rangeStmt, ok := node.(*ast.RangeStmt); ok {
+			if _, ok := typeOfUnderlying(rangeStmt.X, ctx).(*types.Map); ok && !collectsKeys(rangeStmt) {
+				issue = gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence)
+			}
+		}
+		return true
+	})
+	return issue, nil
+}
+
+// collectsKeys returns true for "for k := range m { keys = append(keys, k) }",
+// the keys being sorted afterwards.
+func collectsKeys(rangeStmt *ast.RangeStmt) bool {
+	key, ok := rangeStmt.Key.(*ast.Ident)
+	if !ok || len(rangeStmt.Body.List) != 1 {
+		return false
+	}
+	assign, ok := rangeStmt.Body.List[0].(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 {
+		return false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || calleeName(call) != "append" || len(call.Args) != 2 {
+		return false
+	}
+	arg, ok := call.Args[1].(*ast.Ident)
+	return ok && arg.Name == key.Name
+}
+
+// isStringMethod returns true for the methods implementing fmt.Stringer
+func isStringMethod(fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	if fn.Recv == nil || fn.Name.Name != "String" {
+		return false
+	}
+	obj, ok := ctx.Info.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return false
+	}
+	sig, ok := obj.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	basic, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+// NewMapRangingStringer detects String methods ranging over a map, which return a
+// different string on every run. Unlike the map ranging rule it also reports the
+// loops writing their output with several statements.
+func NewMapRangingStringer(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &mapRangingStringer{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "String method ranges over a map and returns a different string on every run",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
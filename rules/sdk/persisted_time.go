@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type persistedTime struct {
+	gosec.MetaData
+	tag   string
+	scope *moduleScope
+}
+
+func (r *persistedTime) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *persistedTime) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	structType, ok := n.(*ast.StructType)
+	if !ok || structType.Fields == nil || !r.scope.contains(structType, ctx) {
+		return nil, nil
+	}
+	for _, field := range structType.Fields.List {
+		t := ctx.Info.TypeOf(field.Type)
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		if t == nil || types.TypeString(t, nil) != "time.Time" || r.isIgnored(field) {
+			continue
+		}
+		return gosec.NewIssue(ctx, field, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isIgnored returns true if the field is excluded from the serialization by its tag
+func (r *persistedTime) isIgnored(field *ast.Field) bool {
+	name, _ := fieldTagName(field, r.tag)
+	return name == "-"
+}
+
+// NewPersistedTime detects time.Time fields in the persisted types, whose encoding
+// carries the location and depends on the monotonic clock reading. The fields
+// excluded by their serialization tag are skipped. Both the tag and the packages
+// holding the persisted types can be configured:
+//
+//	{"G719": {"tag": "yaml", "scope": "(?i)^(types|state)$"}}
+func NewPersistedTime(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &persistedTime{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "time.Time stored in a persisted type, use an int64 unix timestamp or a canonical UTC encoding instead",
+		},
+		tag:   serializationTag(id, conf),
+		scope: newModuleScopeWithDefault(id, conf, defaultPersistedScope),
+	}, []ast.Node{(*ast.StructType)(nil)}
+}
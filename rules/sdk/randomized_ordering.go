@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type randomizedOrdering struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *randomizedOrdering) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *randomizedOrdering) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, nil
+	}
+
+	randomized := false
+	switch sel.Sel.Name {
+	case "Shuffle", "Perm":
+		randomized = isRandPackage(sel.X, ctx) || isRandSource(sel.X, ctx)
+	case "Slice", "SliceStable":
+		randomized = isPackage(sel.X, ctx, "sort") && len(call.Args) == 2 && callsRand(call.Args[1], ctx)
+	case "SortFunc", "SortStableFunc":
+		randomized = isPackage(sel.X, ctx, "slices", "golang.org/x/exp/slices") && len(call.Args) == 2 && callsRand(call.Args[1], ctx)
+	}
+	if !randomized || !r.scope.contains(call, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isPackage returns true if the expression refers to an import of one of the
+// given package paths, whatever the name it is imported with.
+func isPackage(expr ast.Expr, ctx *gosec.Context, paths ...string) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkg, ok := ctx.Info.Uses[ident].(*types.PkgName)
+	if !ok {
+		return false
+	}
+	for _, path := range paths {
+		if pkg.Imported().Path() == path {
+			return true
+		}
+	}
+	return false
+}
+
+func isRandPackage(expr ast.Expr, ctx *gosec.Context) bool {
+	return isPackage(expr, ctx, "math/rand", "math/rand/v2")
+}
+
+// isRandSource returns true for the values of type *rand.Rand
+func isRandSource(expr ast.Expr, ctx *gosec.Context) bool {
+	t := ctx.Info.TypeOf(expr)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	path := named.Obj().Pkg().Path()
+	return named.Obj().Name() == "Rand" && (path == "math/rand" || path == "math/rand/v2")
+}
+
+// callsRand returns true if the comparator calls into the rand package
+func callsRand(comparator ast.Expr, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(comparator, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok && (isRandPackage(sel.X, ctx) || isRandSource(sel.X, ctx)) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewRandomizedOrdering detects collections shuffled or sorted with a random
+// comparator in the state machine, e.g. validator sets or transactions, whose
+// order then differs between the nodes and splits the chain.
+func NewRandomizedOrdering(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &randomizedOrdering{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Collection ordered randomly in the state machine, the order differs between the nodes",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unboundedRecursion struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *unboundedRecursion) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the functions calling themselves on a value derived from their
+// parameters, e.g. the nested messages of a message, when none of their integer
+// parameters is compared to bound the depth of the recursion.
+func (r *unboundedRecursion) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil {
+		return nil, nil
+	}
+	self := ctx.Info.Defs[fn.Name]
+	if self == nil {
+		return nil, nil
+	}
+
+	derived := make(map[types.Object]bool)
+	var depths []types.Object
+	for _, list := range []*ast.FieldList{fn.Recv, fn.Type.Params} {
+		if list == nil {
+			continue
+		}
+		for _, field := range list.List {
+			for _, name := range field.Names {
+				obj := ctx.Info.Defs[name]
+				if obj == nil {
+					continue
+				}
+				derived[obj] = true
+				if basic, ok := obj.Type().Underlying().(*types.Basic); ok && basic.Info()&types.IsInteger != 0 {
+					depths = append(depths, obj)
+				}
+			}
+		}
+	}
+
+	var recursion *ast.CallExpr
+	bounded := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.RangeStmt:
+			if r.refersToAny(node.X, derived, ctx) {
+				for _, expr := range []ast.Expr{node.Key, node.Value} {
+					if ident, ok := expr.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) != nil {
+						derived[ctx.Info.ObjectOf(ident)] = true
+					}
+				}
+			}
+		case *ast.AssignStmt:
+			if len(node.Lhs) == len(node.Rhs) {
+				for i, rhs := range node.Rhs {
+					if ident, ok := node.Lhs[i].(*ast.Ident); ok && ctx.Info.ObjectOf(ident) != nil && r.refersToAny(rhs, derived, ctx) {
+						derived[ctx.Info.ObjectOf(ident)] = true
+					}
+				}
+			}
+		case *ast.BinaryExpr:
+			switch node.Op {
+			case token.LSS, token.LEQ, token.GTR, token.GEQ, token.EQL:
+				for _, depth := range depths {
+					bounded = bounded || r.refersToAny(node, map[types.Object]bool{depth: true}, ctx)
+				}
+			}
+		case *ast.CallExpr:
+			if recursion == nil && r.isSelfCall(node, self, ctx) {
+				for _, arg := range node.Args {
+					if r.refersToAny(arg, derived, ctx) {
+						recursion = node
+						break
+					}
+				}
+			}
+		}
+		return true
+	})
+	if recursion == nil || bounded || !r.scope.contains(fn, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, recursion, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isSelfCall returns true if the call is a direct call of the given function or method
+func (r *unboundedRecursion) isSelfCall(call *ast.CallExpr, self types.Object, ctx *gosec.Context) bool {
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return ctx.Info.ObjectOf(fun) == self
+	case *ast.SelectorExpr:
+		return ctx.Info.ObjectOf(fun.Sel) == self
+	}
+	return false
+}
+
+// refersToAny returns true if the expression references any of the objects
+func (r *unboundedRecursion) refersToAny(expr ast.Node, objs map[types.Object]bool, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && objs[ctx.Info.ObjectOf(ident)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnboundedRecursion detects the handlers calling themselves on a value
+// derived from their parameters, e.g. the nested messages of a message, with
+// no depth parameter bounding the recursion. A deep enough nesting provided by
+// a user blows the stack and halts the node. This is a heuristic, and the scope
+// can be configured:
+//
+//	{"G736": {"scope": "(?i)^(keeper|ante)$"}}
+func NewUnboundedRecursion(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unboundedRecursion{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Recursion on user provided data without a bound on its depth",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
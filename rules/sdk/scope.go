@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"go/ast"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultModuleScope matches the directories, packages, functions and receiver
+// types which typically make up the state machine of a Cosmos-SDK chain.
+const defaultModuleScope = `(?i)^(.*keeper|keepers|.*handler|handlers|msg_?server|abci|ante|handle.+)$`
+
+// moduleScope restricts a rule to the state machine code i.e. keepers, message
+// servers and ABCI handlers. Test files are never in scope. The pattern can be
+// configured per rule, for example:
+//
+//	{"G706": {"scope": "(?i)^(keeper|simulation)$"}}
+type moduleScope struct {
+	pattern *regexp.Regexp
+}
+
+func newModuleScope(id string, conf gosec.Config) *moduleScope {
+	return newModuleScopeWithDefault(id, conf, defaultModuleScope)
+}
+
+// newModuleScopeWithDefault is used by the rules which apply to other parts of
+// a module than its state machine, e.g. the types which are persisted.
+func newModuleScopeWithDefault(id string, conf gosec.Config, defaultScope string) *moduleScope {
+	pattern := regexp.MustCompile(defaultScope)
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if cfgScope, ok := settings["scope"].(string); ok {
+				if re, err := regexp.Compile(cfgScope); err == nil {
+					pattern = re
+				}
+			}
+		}
+	}
+	return &moduleScope{pattern: pattern}
+}
+
+// contains returns true if any of the directories of the file, the package
+// name, the enclosing function name or its receiver type match the scope.
+func (s *moduleScope) contains(n ast.Node, ctx *gosec.Context) bool {
+	file := ctx.FileSet.File(n.Pos()).Name()
+	if strings.HasSuffix(file, "_test.go") {
+		return false
+	}
+
+	names := strings.Split(filepath.ToSlash(filepath.Dir(file)), "/")
+	names = append(names, ctx.Pkg.Name())
+	if fn := gosec.GetEnclosingFuncDecl(n, ctx); fn != nil {
+		names = append(names, fn.Name.Name)
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			names = append(names, receiverTypeName(fn.Recv.List[0].Type))
+		}
+	}
+	for _, name := range names {
+		if name != "" && s.pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// receiverTypeName returns the name of the type from a method receiver
+// expression such as "k Keeper", "k *Keeper" or "k *Keeper[T]".
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultPersistedScope matches the packages holding the types of a module
+// which are stored or exchanged with the clients.
+const defaultPersistedScope = `(?i)^types$`
+
+type missingSerializationTag struct {
+	gosec.MetaData
+	tag        string
+	consistent []string
+	scope      *moduleScope
+}
+
+func (r *missingSerializationTag) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *missingSerializationTag) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	structType, ok := n.(*ast.StructType)
+	if !ok || structType.Fields == nil || !r.scope.contains(structType, ctx) {
+		return nil, nil
+	}
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			value, found := fieldTagName(field, r.tag)
+			if !found {
+				what := fmt.Sprintf(r.What, name.Name, "has no "+r.tag+" tag")
+				return gosec.NewIssue(ctx, field, r.ID(), what, r.Severity, r.Confidence), nil
+			}
+			if value == "-" {
+				what := fmt.Sprintf(r.What, name.Name, "is excluded by its "+r.tag+" tag")
+				return gosec.NewIssue(ctx, field, r.ID(), what, r.Severity, r.Confidence), nil
+			}
+			for _, other := range r.consistent {
+				if otherValue, found := fieldTagName(field, other); found && !sameTagName(value, otherValue) {
+					what := fmt.Sprintf(r.What, name.Name, "has "+r.tag+" and "+other+" tags naming it differently")
+					return gosec.NewIssue(ctx, field, r.ID(), what, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// sameTagName returns true if two tags give the same name to the field. A tag
+// without a name, e.g. amino:",omitempty", only carries options and agrees.
+func sameTagName(a, b string) bool {
+	return a == "" || b == "" || a == b
+}
+
+// NewMissingSerializationTag detects exported fields of the persisted types which
+// lack a serialization tag, or are excluded by it, so that their encoding depends
+// on the field names and may change between versions. The fields whose other
+// tags, e.g. amino, name them differently are reported too, as the encodings
+// then disagree. The tags and the packages holding the persisted types can be
+// configured:
+//
+//	{"G718": {"tag": "yaml", "consistent": ["amino"], "scope": "(?i)^(types|state)$"}}
+func NewMissingSerializationTag(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	consistent := []string{"amino"}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["consistent"].([]interface{}); ok {
+				consistent = nil
+				for _, tag := range configured {
+					if tag, ok := tag.(string); ok {
+						consistent = append(consistent, tag)
+					}
+				}
+			}
+		}
+	}
+	return &missingSerializationTag{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Field %s of a persisted type %s",
+		},
+		tag:        serializationTag(id, conf),
+		consistent: consistent,
+		scope:      newModuleScopeWithDefault(id, conf, defaultPersistedScope),
+	}, []ast.Node{(*ast.StructType)(nil)}
+}
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type sleepInConsensus struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *sleepInConsensus) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *sleepInConsensus) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	if node, matched := gosec.MatchCallByPackage(n, ctx, "time", "Sleep"); matched && r.scope.contains(node, ctx) {
+		return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewSleepInConsensus detects calls to time.Sleep in keepers and handlers, which stall
+// block processing and couple the state machine to wall-clock timing.
+func NewSleepInConsensus(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &sleepInConsensus{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Blocking the state machine with time.Sleep",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type partialLess struct {
+	gosec.MetaData
+}
+
+func (r *partialLess) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the Less methods of the sort.Interface implementations comparing
+// a single field of elements which have other fields to break the ties.
+func (r *partialLess) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Recv == nil || fn.Name.Name != "Less" || !comparesSingleField(fn.Body) {
+		return nil, nil
+	}
+	obj, ok := ctx.Info.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return nil, nil
+	}
+	sig := obj.Type().(*types.Signature)
+	if sig.Params().Len() != 2 || sig.Results().Len() != 1 {
+		return nil, nil
+	}
+	slice, ok := derefType(sig.Recv().Type()).Underlying().(*types.Slice)
+	if !ok {
+		return nil, nil
+	}
+	elem, ok := derefType(slice.Elem()).Underlying().(*types.Struct)
+	if !ok || elem.NumFields() < 2 {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, fn, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// derefType returns the type a pointer points to, or the type itself
+func derefType(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
+
+// NewPartialLess detects the Less methods of slice types implementing
+// sort.Interface which compare a single field of elements having several. The
+// elements tying on this field are left in an unspecified order by sort.Sort,
+// which can differ between the nodes. The other fields should be compared to
+// make Less a total order.
+func NewPartialLess(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &partialLess{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Less compares a single field and may tie, compare the other fields to make it a total order",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
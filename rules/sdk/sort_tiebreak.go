@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type sortWithoutTieBreaker struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *sortWithoutTieBreaker) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *sortWithoutTieBreaker) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	node, matched := gosec.MatchCallByPackage(n, ctx, "sort", "Slice", "SliceStable")
+	if !matched || len(node.Args) != 2 {
+		return nil, nil
+	}
+	less, ok := node.Args[1].(*ast.FuncLit)
+	if !ok || !comparesSingleField(less.Body) || !r.scope.contains(node, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, node, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// comparesSingleField returns true if the body of the comparator is only
+// "return s[i].Field < s[j].Field", which leaves the records with equal fields
+// in an unspecified order.
+func comparesSingleField(body *ast.BlockStmt) bool {
+	if body == nil || len(body.List) != 1 {
+		return false
+	}
+	ret, ok := body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return false
+	}
+	cmp, ok := unparen(ret.Results[0]).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch cmp.Op {
+	case token.LSS, token.GTR, token.LEQ, token.GEQ:
+	default:
+		return false
+	}
+	// Comparing the whole elements, e.g. s[i] < s[j], is a total order.
+	_, left := unparen(cmp.X).(*ast.SelectorExpr)
+	_, right := unparen(cmp.Y).(*ast.SelectorExpr)
+	return left && right
+}
+
+// NewSortWithoutTieBreaker detects sort.Slice comparators ordering the records on a
+// single field, which leaves the order of the records comparing equal undefined.
+func NewSortWithoutTieBreaker(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &sortWithoutTieBreaker{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Sorting on a single field may tie, add tie-breakers to make it a total order",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,247 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unprefixedStoreKey struct {
+	gosec.MetaData
+	storeTypes map[string]bool
+	methods    map[string]bool
+}
+
+func (r *unprefixedStoreKey) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the keys passed to the stores which are built by appending or
+// joining several variable length components. The key is followed through the
+// assignments of the calling function and through the functions of the package
+// returning it.
+func (r *unprefixedStoreKey) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !r.methods[sel.Sel.Name] || !r.isStore(ctx.Info.TypeOf(sel.X)) {
+		return nil, nil
+	}
+	key := r.keyBuild(call.Args[0], call, ctx)
+	if key == nil {
+		return nil, nil
+	}
+	variable := 0
+	for _, component := range keyComponents(key, ctx) {
+		if isVariableLength(component, ctx) {
+			variable++
+		}
+	}
+	if variable < 2 {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, key, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isStore returns true if the type, or the type it points to, is one of the
+// configured store types, matched by name.
+func (r *unprefixedStoreKey) isStore(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && r.storeTypes[named.Obj().Name()]
+}
+
+// keyBuild returns the append or bytes.Join call building the key, following a
+// variable to its last assignment before the store call, or a function of the
+// package to the value it returns.
+func (r *unprefixedStoreKey) keyBuild(expr ast.Expr, at ast.Node, ctx *gosec.Context) *ast.CallExpr {
+	switch e := unparen(expr).(type) {
+	case *ast.CallExpr:
+		if isBuiltin(e.Fun, ctx, "append") || isBytesJoin(e, ctx) {
+			return e
+		}
+		if decl := packageFunc(e, ctx); decl != nil && decl.Body != nil && len(decl.Body.List) > 0 {
+			if ret, ok := decl.Body.List[len(decl.Body.List)-1].(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+				if build, ok := unparen(ret.Results[0]).(*ast.CallExpr); ok && (isBuiltin(build.Fun, ctx, "append") || isBytesJoin(build, ctx)) {
+					return build
+				}
+			}
+		}
+	case *ast.Ident:
+		obj := ctx.Info.ObjectOf(e)
+		fn := gosec.GetEnclosingFuncDecl(at, ctx)
+		if obj == nil || fn == nil || fn.Body == nil {
+			return nil
+		}
+		var value ast.Expr
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			if node == nil || node.Pos() >= at.Pos() {
+				return false
+			}
+			if assign, ok := node.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+				for i, lhs := range assign.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+						value = assign.Rhs[i]
+					}
+				}
+			}
+			return true
+		})
+		if call, ok := unparen(value).(*ast.CallExpr); ok && (isBuiltin(call.Fun, ctx, "append") || isBytesJoin(call, ctx)) {
+			return call
+		}
+	}
+	return nil
+}
+
+// packageFunc returns the declaration of the function of the analyzed package
+// which is called, if any.
+func packageFunc(call *ast.CallExpr, ctx *gosec.Context) *ast.FuncDecl {
+	var ident *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+	obj, ok := ctx.Info.ObjectOf(ident).(*types.Func)
+	if !ok || obj.Pkg() != ctx.Pkg {
+		return nil
+	}
+	for _, file := range ctx.PkgFiles {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && ctx.Info.Defs[fn.Name] == obj {
+				return fn
+			}
+		}
+	}
+	return nil
+}
+
+// isBytesJoin returns true if the call is bytes.Join
+func isBytesJoin(call *ast.CallExpr, ctx *gosec.Context) bool {
+	_, matches := gosec.MatchCallByPackage(call, ctx, "bytes", "Join")
+	return matches
+}
+
+// keyComponents returns the components concatenated by nested append calls, or
+// joined by bytes.Join from a slice literal.
+func keyComponents(call *ast.CallExpr, ctx *gosec.Context) []ast.Expr {
+	if isBytesJoin(call, ctx) {
+		if len(call.Args) == 0 {
+			return nil
+		}
+		if lit, ok := unparen(call.Args[0]).(*ast.CompositeLit); ok {
+			return lit.Elts
+		}
+		return nil
+	}
+	if len(call.Args) == 0 {
+		return nil
+	}
+	var components []ast.Expr
+	if inner, ok := unparen(call.Args[0]).(*ast.CallExpr); ok && isBuiltin(inner.Fun, ctx, "append") {
+		components = keyComponents(inner, ctx)
+	} else {
+		components = append(components, call.Args[0])
+	}
+	// appending single bytes, e.g. a separator, adds no variable length component
+	if call.Ellipsis.IsValid() {
+		components = append(components, call.Args[len(call.Args)-1])
+	}
+	return components
+}
+
+// isVariableLength returns true if the component is a conversion of a string
+// variable, the bytes of an address or other value, or a byte slice variable,
+// and not a constant or a length prefixed value.
+func isVariableLength(expr ast.Expr, ctx *gosec.Context) bool {
+	expr = unparen(expr)
+	if tv, ok := ctx.Info.Types[expr]; ok && tv.Value != nil {
+		return false
+	}
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		if tv, ok := ctx.Info.Types[e.Fun]; ok && tv.IsType() {
+			return len(e.Args) == 1 && isVariableLength(e.Args[0], ctx)
+		}
+		name := calleeName(e)
+		return name == "Bytes" || name == "String"
+	case *ast.Ident, *ast.SelectorExpr:
+		typ := ctx.Info.TypeOf(e)
+		if typ == nil {
+			return false
+		}
+		switch t := typ.Underlying().(type) {
+		case *types.Slice:
+			// the key prefixes of the modules are fixed, e.g. DelegationPrefix
+			basic, ok := t.Elem().Underlying().(*types.Basic)
+			return ok && basic.Kind() == types.Byte && !strings.Contains(strings.ToLower(identName(e)), "prefix")
+		case *types.Basic:
+			return t.Info()&types.IsString != 0
+		}
+	}
+	return false
+}
+
+// identName returns the name of an identifier or of the selected field
+func identName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+// NewUnprefixedStoreKey detects the store keys built by appending or joining
+// several variable length components, e.g. two addresses, without prefixing
+// them with their length. Different components then produce the same key, e.g.
+// "ab" and "c" collide with "a" and "bc", and records overwrite each other. The
+// components should be length prefixed, e.g. with address.MustLengthPrefix. The
+// store types and methods can be configured:
+//
+//	{"G738": {"types": ["KVStore"], "methods": ["Set", "Get"]}}
+func NewUnprefixedStoreKey(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	storeTypes := map[string]bool{"KVStore": true, "CommitKVStore": true, "Store": true}
+	methods := map[string]bool{"Set": true, "Get": true, "Has": true, "Delete": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				storeTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						storeTypes[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &unprefixedStoreKey{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Store key concatenating variable length components, prefix them with their length",
+		},
+		storeTypes: storeTypes,
+		methods:    methods,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
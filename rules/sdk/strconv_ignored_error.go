@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"go/ast"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type ignoredParseError struct {
+	gosec.MetaData
+	calls gosec.CallList
+}
+
+func (r *ignoredParseError) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *ignoredParseError) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.AssignStmt)
+	if !ok || len(stmt.Rhs) != 1 || len(stmt.Lhs) != 2 {
+		return nil, nil
+	}
+	call := r.calls.ContainsPkgCallExpr(stmt.Rhs[0], ctx, false)
+	if call == nil {
+		return nil, nil
+	}
+	pos := returnsError(call, ctx)
+	if pos < 0 || pos >= len(stmt.Lhs) {
+		return nil, nil
+	}
+	if errIdent, ok := stmt.Lhs[pos].(*ast.Ident); !ok || errIdent.Name != "_" {
+		return nil, nil
+	}
+
+	// the parsed value is zero or clamped to the bounds on error
+	value, ok := stmt.Lhs[1-pos].(*ast.Ident)
+	if !ok || value.Name == "_" {
+		return nil, nil
+	}
+	obj := ctx.Info.ObjectOf(value)
+	fn := gosec.GetEnclosingFuncDecl(stmt, ctx)
+	if obj == nil || fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+	used := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && ident.Pos() > stmt.End() && ctx.Info.Uses[ident] == obj {
+			used = true
+		}
+		return !used
+	})
+	if !used {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, stmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewIgnoredParseError detects integers parsed with strconv while ignoring the
+// error, the value being used afterwards. The value is zero when the input is
+// invalid and clamped to the bounds of the bit size when it is out of range,
+// which the bit size checks of G704 cannot detect.
+func NewIgnoredParseError(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("strconv", "Atoi", "ParseInt", "ParseUint")
+	return &ignoredParseError{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.High,
+			What:       "Error of the integer parsing ignored while the parsed value is used",
+		},
+		calls: calls,
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
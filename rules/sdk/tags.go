@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultSerializationTag is the tag naming the fields of the persisted types
+const defaultSerializationTag = "json"
+
+// fieldTagName returns the name given to the field by a struct tag, without its
+// options such as omitempty, and whether the field has the tag.
+func fieldTagName(field *ast.Field, key string) (string, bool) {
+	if field.Tag == nil {
+		return "", false
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false
+	}
+	value, found := reflect.StructTag(tag).Lookup(key)
+	return strings.Split(value, ",")[0], found
+}
+
+// serializationTag returns the tag naming the fields of the persisted types, as
+// configured for the rule with the "tag" setting.
+func serializationTag(id string, conf gosec.Config) string {
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["tag"].(string); ok && configured != "" {
+				return configured
+			}
+		}
+	}
+	return defaultSerializationTag
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unclosedIterator struct {
+	gosec.MetaData
+	constructors map[string]bool
+	methods      map[string]bool
+	scope        *moduleScope
+}
+
+func (r *unclosedIterator) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *unclosedIterator) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	call, ok := unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok || !r.constructors[calleeName(call)] {
+		return nil, nil
+	}
+	iter, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	fn := gosec.GetEnclosingFuncDecl(assign, ctx)
+	if fn == nil || fn.Body == nil || !r.scope.contains(assign, ctx) {
+		return nil, nil
+	}
+	if iter.Name != "_" && r.isReleased(fn.Body, ctx.Info.ObjectOf(iter), ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isReleased returns true if the iterator is closed, deferred or not, or handed
+// over to the caller or to another function which then owns it.
+func (r *unclosedIterator) isReleased(body *ast.BlockStmt, obj types.Object, ctx *gosec.Context) bool {
+	if obj == nil {
+		return true
+	}
+	isIter := func(expr ast.Expr) bool {
+		ident, ok := unparen(expr).(*ast.Ident)
+		return ok && ctx.Info.ObjectOf(ident) == obj
+	}
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			if sel, ok := node.Fun.(*ast.SelectorExpr); ok && r.methods[sel.Sel.Name] && isIter(sel.X) {
+				found = true
+			}
+			for _, arg := range node.Args {
+				found = found || isIter(arg)
+			}
+		case *ast.ReturnStmt:
+			for _, result := range node.Results {
+				found = found || isIter(result)
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnclosedIterator detects store iterators which are never closed, keeping the
+// resources of the store, and for some backends a read lock, until the block is
+// committed. The iterators should be closed with defer right after their creation.
+// The constructors of the iterators and the closing methods can be configured
+// along with the scope:
+//
+//	{"G731": {"constructors": ["Iterator", "ReverseIterator"], "methods": ["Close"]}}
+func NewUnclosedIterator(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	constructors := map[string]bool{
+		"Iterator":                     true,
+		"ReverseIterator":              true,
+		"KVStorePrefixIterator":        true,
+		"KVStoreReversePrefixIterator": true,
+	}
+	methods := map[string]bool{"Close": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["constructors"].([]interface{}); ok {
+				constructors = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						constructors[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &unclosedIterator{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Store iterator is not closed, defer its Close after creating it",
+		},
+		constructors: constructors,
+		methods:      methods,
+		scope:        newModuleScope(id, conf),
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unmeteredIterator struct {
+	gosec.MetaData
+	iteratorTypes map[string]bool
+	gasFunctions  map[string]bool
+	scope         *moduleScope
+}
+
+func (r *unmeteredIterator) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the for iter.Valid(); iter.Next() loops over a store iterator which
+// do not consume gas on every iteration.
+func (r *unmeteredIterator) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	forStmt, ok := n.(*ast.ForStmt)
+	if !ok || forStmt.Cond == nil {
+		return nil, nil
+	}
+	call, ok := unparen(forStmt.Cond).(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Valid" || !r.isIterator(ctx.Info.TypeOf(sel.X)) {
+		return nil, nil
+	}
+	if consumesGas(forStmt.Body, r.gasFunctions) || !r.scope.contains(forStmt, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, forStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isIterator returns true if the type, or the type it points to, is one of the
+// configured iterator types, matched by name since the store iterators are
+// declared by several packages.
+func (r *unmeteredIterator) isIterator(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && r.iteratorTypes[named.Obj().Name()]
+}
+
+// NewUnmeteredIterator detects loops over store iterators in the modules which do
+// not consume gas, letting the number of iterations, and the time spent by the
+// validators, grow with the size of the store. The iterator types, the gas
+// consuming functions and the scope can be configured:
+//
+//	{"G730": {"types": ["Iterator"], "gas_functions": ["ConsumeGas"], "scope": "(?i)^keeper$"}}
+func NewUnmeteredIterator(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	iteratorTypes := map[string]bool{"Iterator": true}
+	gasFunctions := map[string]bool{"ConsumeGas": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				iteratorTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						iteratorTypes[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["gas_functions"].([]interface{}); ok {
+				gasFunctions = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						gasFunctions[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &unmeteredIterator{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Loop over a store iterator without consuming gas",
+		},
+		iteratorTypes: iteratorTypes,
+		gasFunctions:  gasFunctions,
+		scope:         newModuleScope(id, conf),
+	}, []ast.Node{(*ast.ForStmt)(nil)}
+}
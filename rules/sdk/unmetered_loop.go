@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unmeteredLoop struct {
+	gosec.MetaData
+	gasFunctions map[string]bool
+	scope        *moduleScope
+}
+
+func (r *unmeteredLoop) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the range loops over the slices and maps received as parameters,
+// e.g. the entries of a message, which do not consume gas on every iteration.
+func (r *unmeteredLoop) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok || rangeStmt.X == nil {
+		return nil, nil
+	}
+	typ := ctx.Info.TypeOf(rangeStmt.X)
+	if typ == nil {
+		return nil, nil
+	}
+	switch typ.Underlying().(type) {
+	case *types.Slice, *types.Map:
+	default:
+		return nil, nil
+	}
+
+	fn := gosec.GetEnclosingFuncDecl(rangeStmt, ctx)
+	if fn == nil || !isParameter(rangeStmt.X, fn, ctx) || consumesGas(rangeStmt.Body, r.gasFunctions) || !r.scope.contains(rangeStmt, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isParameter returns true if the expression is a parameter of the function or one of its fields
+func isParameter(expr ast.Expr, fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	for {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.Ident:
+			obj := ctx.Info.ObjectOf(e)
+			if obj == nil || fn.Type.Params == nil {
+				return false
+			}
+			for _, field := range fn.Type.Params.List {
+				for _, name := range field.Names {
+					if ctx.Info.Defs[name] == obj {
+						return true
+					}
+				}
+			}
+			return false
+		default:
+			return false
+		}
+	}
+}
+
+// consumesGas returns true if the loop body calls any of the gas consuming functions
+func consumesGas(body *ast.BlockStmt, gasFunctions map[string]bool) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok && gasFunctions[calleeName(call)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnmeteredLoop detects loops over user provided slices or maps in handlers
+// which do not consume gas, letting a large message exhaust the resources of
+// the validators. Both the scope and the gas consuming functions can be
+// configured, for example:
+//
+//	{"G714": {"scope": "(?i)^handler$", "gas_functions": ["ConsumeGas", "chargeGas"]}}
+func NewUnmeteredLoop(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	gasFunctions := map[string]bool{
+		"ConsumeGas": true,
+	}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if functions, ok := settings["gas_functions"].([]interface{}); ok {
+				gasFunctions = make(map[string]bool)
+				for _, fn := range functions {
+					if name, ok := fn.(string); ok {
+						gasFunctions[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &unmeteredLoop{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Loop over user provided input without consuming gas",
+		},
+		gasFunctions: gasFunctions,
+		scope:        newModuleScope(id, conf),
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
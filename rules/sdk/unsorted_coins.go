@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unsortedCoins struct {
+	gosec.MetaData
+	coinTypes map[string]bool
+	methods   map[string]bool
+}
+
+func (r *unsortedCoins) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the functions returning coins appended in a loop which are never
+// sorted nor validated once the loop is done.
+func (r *unsortedCoins) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil || !r.returnsCoins(fn, ctx) {
+		return nil, nil
+	}
+
+	// coins appended in a loop along with the loop appending them
+	appended := make(map[types.Object]ast.Node)
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		var body *ast.BlockStmt
+		switch loop := node.(type) {
+		case *ast.ForStmt:
+			body = loop.Body
+		case *ast.RangeStmt:
+			body = loop.Body
+		default:
+			return true
+		}
+		ast.Inspect(body, func(inner ast.Node) bool {
+			assign, ok := inner.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+				return true
+			}
+			call, ok := assign.Rhs[0].(*ast.CallExpr)
+			if !ok || calleeName(call) != "append" {
+				return true
+			}
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+				if obj := ctx.Info.ObjectOf(ident); obj != nil && r.isCoins(obj.Type()) {
+					appended[obj] = node
+				}
+			}
+			return true
+		})
+		return true
+	})
+	if len(appended) == 0 {
+		return nil, nil
+	}
+
+	var issue *gosec.Issue
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		ret, ok := node.(*ast.ReturnStmt)
+		if !ok || issue != nil {
+			return issue == nil
+		}
+		for _, result := range ret.Results {
+			ident, ok := result.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			obj := ctx.Info.ObjectOf(ident)
+			loop, ok := appended[obj]
+			if ok && ret.Pos() > loop.End() && !r.sortedBetween(fn.Body, obj, loop, ret, ctx) {
+				issue = gosec.NewIssue(ctx, ret, r.ID(), r.What, r.Severity, r.Confidence)
+			}
+		}
+		return true
+	})
+	return issue, nil
+}
+
+func (r *unsortedCoins) isCoins(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && r.coinTypes[named.Obj().Name()]
+}
+
+func (r *unsortedCoins) returnsCoins(fn *ast.FuncDecl, ctx *gosec.Context) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, field := range fn.Type.Results.List {
+		if r.isCoins(ctx.Info.TypeOf(field.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedBetween returns true if one of the configured methods is called on the
+// coins after the loop and before they are returned.
+func (r *unsortedCoins) sortedBetween(body *ast.BlockStmt, obj types.Object, loop ast.Node, ret *ast.ReturnStmt, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || call.Pos() < loop.End() || call.Pos() > ret.Pos() {
+			return !found
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !r.methods[sel.Sel.Name] {
+			return !found
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnsortedCoins detects functions returning coins appended in a loop without
+// sorting or validating them, while the SDK relies on coins being sorted. This is
+// a heuristic reported with a low confidence. The coin types are matched by name
+// and can be configured along with the methods sorting or validating them:
+//
+//	{"G722": {"types": ["Coins", "DecCoins"], "methods": ["Sort", "Validate"]}}
+func NewUnsortedCoins(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	coinTypes := map[string]bool{"Coins": true, "DecCoins": true}
+	methods := map[string]bool{"Sort": true, "Validate": true, "IsValid": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["types"].([]interface{}); ok {
+				coinTypes = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						coinTypes[name] = true
+					}
+				}
+			}
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &unsortedCoins{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Coins appended in a loop are returned without being sorted or validated",
+		},
+		coinTypes: coinTypes,
+		methods:   methods,
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
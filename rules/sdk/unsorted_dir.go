@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unsortedDirListing struct {
+	gosec.MetaData
+	scope *moduleScope
+}
+
+func (r *unsortedDirListing) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the loops ranging over the entries read from an open directory,
+// either directly or through a variable which is not sorted before the loop.
+func (r *unsortedDirListing) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	rangeStmt, ok := n.(*ast.RangeStmt)
+	if !ok {
+		return nil, nil
+	}
+	switch x := unparen(rangeStmt.X).(type) {
+	case *ast.CallExpr:
+		if !isDirListing(x, ctx) {
+			return nil, nil
+		}
+	case *ast.Ident:
+		obj := ctx.Info.ObjectOf(x)
+		fn := gosec.GetEnclosingFuncDecl(rangeStmt, ctx)
+		if obj == nil || fn == nil || fn.Body == nil {
+			return nil, nil
+		}
+		listing := listedAt(fn.Body, obj, rangeStmt, ctx)
+		if listing == nil || sortedBefore(fn.Body, obj, listing, rangeStmt, ctx) {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	if !r.scope.contains(rangeStmt, ctx) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, rangeStmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isDirListing returns true for the methods of *os.File reading the entries of
+// a directory, which are returned in the order of the file system.
+func isDirListing(call *ast.CallExpr, ctx *gosec.Context) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	switch sel.Sel.Name {
+	case "Readdir", "Readdirnames", "ReadDir":
+	default:
+		return false
+	}
+	t := ctx.Info.TypeOf(sel.X)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return t != nil && types.TypeString(t, nil) == "os.File"
+}
+
+// listedAt returns the last assignment of the directory entries to the variable
+// before the loop.
+func listedAt(body *ast.BlockStmt, obj types.Object, before ast.Node, ctx *gosec.Context) ast.Node {
+	var listing ast.Node
+	ast.Inspect(body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Pos() > before.Pos() || len(assign.Rhs) != 1 {
+			return true
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok || !isDirListing(call, ctx) {
+			return true
+		}
+		if ident, ok := assign.Lhs[0].(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+			listing = assign
+		}
+		return true
+	})
+	return listing
+}
+
+// sortedBefore returns true if the variable is passed to the sort or slices
+// packages between the listing and the loop.
+func sortedBefore(body *ast.BlockStmt, obj types.Object, listing ast.Node, loop ast.Node, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || call.Pos() < listing.End() || call.Pos() > loop.Pos() || len(call.Args) == 0 {
+			return !found
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isPackage(sel.X, ctx, "sort", "slices", "golang.org/x/exp/slices") {
+			return !found
+		}
+		if ident, ok := unparen(call.Args[0]).(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+			found = true
+		}
+		// sort.Sort(byName(entries))
+		if conv, ok := unparen(call.Args[0]).(*ast.CallExpr); ok && len(conv.Args) == 1 {
+			if ident, ok := unparen(conv.Args[0]).(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == obj {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUnsortedDirListing detects loops over the entries of a directory read with
+// the methods of os.File, which are returned in the order of the file system and
+// differ between the nodes. Unlike os.ReadDir, they are not sorted by name.
+func NewUnsortedDirListing(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unsortedDirListing{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Directory entries ranged over in file system order, sort them first",
+		},
+		scope: newModuleScope(id, conf),
+	}, []ast.Node{(*ast.RangeStmt)(nil)}
+}
@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type useBeforeValidation struct {
+	gosec.MetaData
+	methods map[string]bool
+}
+
+func (r *useBeforeValidation) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the functions validating a message parameter with one of the
+// configured methods after having read its fields.
+func (r *useBeforeValidation) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil || fn.Type.Params == nil {
+		return nil, nil
+	}
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			obj := ctx.Info.Defs[name]
+			if obj == nil || !r.hasValidation(obj.Type()) {
+				continue
+			}
+			validated := r.validation(fn.Body, obj, ctx)
+			if !validated.IsValid() {
+				continue
+			}
+			if use := firstFieldUse(fn.Body, obj, ctx); use != nil && use.Pos() < validated {
+				return gosec.NewIssue(ctx, use, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// hasValidation returns true if the type has one of the validation methods
+func (r *useBeforeValidation) hasValidation(t types.Type) bool {
+	for name := range r.methods {
+		if obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name); obj != nil {
+			if _, ok := obj.(*types.Func); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validation returns the position of the first call of a validation method on
+// the message, or token.NoPos if it is never validated.
+func (r *useBeforeValidation) validation(body *ast.BlockStmt, msg types.Object, ctx *gosec.Context) token.Pos {
+	pos := token.NoPos
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || pos.IsValid() {
+			return !pos.IsValid()
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && r.methods[sel.Sel.Name] {
+			if ident, ok := unparen(sel.X).(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == msg {
+				pos = call.Pos()
+			}
+		}
+		return !pos.IsValid()
+	})
+	return pos
+}
+
+// firstFieldUse returns the first selection of a field of the message
+func firstFieldUse(body *ast.BlockStmt, msg types.Object, ctx *gosec.Context) *ast.SelectorExpr {
+	var use *ast.SelectorExpr
+	ast.Inspect(body, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok || use != nil {
+			return use == nil
+		}
+		if ident, ok := unparen(sel.X).(*ast.Ident); ok && ctx.Info.ObjectOf(ident) == msg {
+			if selection, ok := ctx.Info.Selections[sel]; ok && selection.Kind() == types.FieldVal {
+				use = sel
+			}
+		}
+		return use == nil
+	})
+	return use
+}
+
+// NewUseBeforeValidation detects the fields of a message read before the message
+// is validated, e.g. with ValidateBasic. The values read may be invalid, and the
+// validation should come first. The messages are the parameters whose type has
+// one of the validation methods, which can be configured:
+//
+//	{"G740": {"methods": ["ValidateBasic", "Validate"]}}
+func NewUseBeforeValidation(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	methods := map[string]bool{"ValidateBasic": true, "Validate": true}
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["methods"].([]interface{}); ok {
+				methods = make(map[string]bool)
+				for _, name := range configured {
+					if name, ok := name.(string); ok {
+						methods[name] = true
+					}
+				}
+			}
+		}
+	}
+	return &useBeforeValidation{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.Low,
+			What:       "Message fields used before the message is validated",
+		},
+		methods: methods,
+	}, []ast.Node{(*ast.FuncDecl)(nil)}
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"go/ast"
+	"regexp"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// defaultValidationPattern matches the names of the validation functions, e.g.
+// ValidateBasic, Validate or ValidateGenesis
+const defaultValidationPattern = `^Validate`
+
+type panicInValidation struct {
+	gosec.MetaData
+	pattern *regexp.Regexp
+}
+
+func (r *panicInValidation) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *panicInValidation) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || !isBuiltin(call.Fun, ctx, "panic") {
+		return nil, nil
+	}
+	fn := gosec.GetEnclosingFuncDecl(call, ctx)
+	if fn == nil || !r.pattern.MatchString(fn.Name.Name) {
+		return nil, nil
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewPanicInValidation detects panics in the validation of messages and genesis
+// states, e.g. in ValidateBasic. The validated data is provided by the users, and
+// an invalid message should be rejected with a typed error instead of relying on
+// the recovery of the panic. The names of the validation functions are matched
+// with a pattern which can be configured:
+//
+//	{"G734": {"pattern": "^(ValidateBasic|Validate)$"}}
+func NewPanicInValidation(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	pattern := defaultValidationPattern
+	if val, ok := conf[id]; ok {
+		if settings, ok := val.(map[string]interface{}); ok {
+			if configured, ok := settings["pattern"].(string); ok {
+				pattern = configured
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = regexp.MustCompile(defaultValidationPattern)
+	}
+	return &panicInValidation{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.High,
+			What:       "Panic in the validation of user provided data, return an error instead",
+		},
+		pattern: re,
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
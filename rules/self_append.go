@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type selfAppend struct {
+	gosec.MetaData
+}
+
+func (r *selfAppend) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *selfAppend) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || !call.Ellipsis.IsValid() || len(call.Args) != 2 {
+		return nil, nil
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	if builtin, ok := ctx.Info.Uses[fun].(*types.Builtin); !ok || builtin.Name() != "append" {
+		return nil, nil
+	}
+
+	// A sliced destination such as append(s[:i], s[i+1:]...) is the idiom to
+	// remove elements, only appending to the whole slice is reported.
+	dst := call.Args[0]
+	src := call.Args[1]
+	for {
+		switch e := src.(type) {
+		case *ast.ParenExpr:
+			src = e.X
+			continue
+		case *ast.SliceExpr:
+			src = e.X
+			continue
+		}
+		break
+	}
+	if sameSlice(dst, src, ctx) {
+		return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// sameSlice returns true if both expressions refer to the same variable or field
+func sameSlice(a, b ast.Expr, ctx *gosec.Context) bool {
+	if a, ok := a.(*ast.Ident); ok {
+		if b, ok := b.(*ast.Ident); ok {
+			obj := ctx.Info.ObjectOf(a)
+			return obj != nil && obj == ctx.Info.ObjectOf(b)
+		}
+		return false
+	}
+	if _, ok := a.(*ast.SelectorExpr); ok {
+		return types.ExprString(a) == types.ExprString(b)
+	}
+	return false
+}
+
+// NewSelfAppend detects slices appended to themselves, which share their backing
+// array with the destination and can be overwritten while being copied.
+func NewSelfAppend(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &selfAppend{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Slice appended to itself, copy the source into a new slice first",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type shadowedName struct {
+	gosec.MetaData
+}
+
+func (r *shadowedName) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the variables, parameters and results named after a builtin
+// function or a package imported by the file. Struct fields are not flagged
+// since they never shadow anything.
+func (r *shadowedName) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	ident, ok := n.(*ast.Ident)
+	if !ok || ident.Name == "_" {
+		return nil, nil
+	}
+	obj, ok := ctx.Info.Defs[ident].(*types.Var)
+	if !ok || obj.IsField() {
+		return nil, nil
+	}
+	if _, ok := types.Universe.Lookup(ident.Name).(*types.Builtin); ok {
+		return gosec.NewIssue(ctx, ident, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	if obj.Parent() != nil && obj.Parent() != ctx.Pkg.Scope() && importsName(ctx.Root, ident.Name, ctx) {
+		return gosec.NewIssue(ctx, ident, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// importsName returns true if the file imports a package under the given name
+func importsName(file *ast.File, name string, ctx *gosec.Context) bool {
+	for _, spec := range file.Imports {
+		var obj types.Object
+		if spec.Name != nil {
+			obj = ctx.Info.Defs[spec.Name]
+		} else {
+			obj = ctx.Info.Implicits[spec]
+		}
+		if pkgName, ok := obj.(*types.PkgName); ok && pkgName.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NewShadowedName detects variables and parameters named after a builtin
+// function, e.g. len or copy, or after an imported package, e.g. url. The
+// builtin or the package cannot be used in their scope, and the code reading
+// them is easily misunderstood.
+func NewShadowedName(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &shadowedName{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "Variable shadowing a builtin function or an imported package",
+		},
+	}, []ast.Node{(*ast.Ident)(nil)}
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+// shiftSizes are the sizes of the integer types of the 64-bit platforms run by
+// the nodes
+var shiftSizes = types.SizesFor("gc", "amd64")
+
+type uncheckedShift struct {
+	gosec.MetaData
+}
+
+func (r *uncheckedShift) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *uncheckedShift) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.BinaryExpr:
+		if node.Op == token.SHL && !r.isBounded(node, node.Y, ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.AssignStmt:
+		if node.Tok == token.SHL_ASSIGN && len(node.Rhs) == 1 && !r.isBounded(node, node.Rhs[0], ctx) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.CallExpr:
+		// a shift bounded by the width of its type still overflows the narrower
+		// integer type it is converted to, the unbounded ones are already reported
+		if len(node.Args) != 1 {
+			return nil, nil
+		}
+		shift, ok := node.Args[0].(*ast.BinaryExpr)
+		if !ok || shift.Op != token.SHL || isConstant(shift, ctx) || !r.isBounded(shift, shift.Y, ctx) {
+			return nil, nil
+		}
+		tv, ok := ctx.Info.Types[node.Fun]
+		if !ok || !tv.IsType() {
+			return nil, nil
+		}
+		dest, ok := tv.Type.Underlying().(*types.Basic)
+		if !ok || dest.Info()&types.IsInteger == 0 {
+			return nil, nil
+		}
+		src := ctx.Info.TypeOf(shift)
+		if src != nil && shiftSizes.Sizeof(dest) < shiftSizes.Sizeof(src) {
+			return gosec.NewIssue(ctx, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// isBounded returns true if the shift count is constant or if one of its
+// variables is compared with a constant by a condition dominating the shift.
+func (r *uncheckedShift) isBounded(n ast.Node, count ast.Expr, ctx *gosec.Context) bool {
+	if isConstant(count, ctx) {
+		return true
+	}
+	var objs []types.Object
+	ast.Inspect(count, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok {
+			if obj, ok := ctx.Info.ObjectOf(ident).(*types.Var); ok {
+				objs = append(objs, obj)
+			}
+		}
+		return true
+	})
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, n.Pos(), n.End())
+	for len(path) > 0 && path[0] != n {
+		path = path[1:]
+	}
+	return isDominatedBy(path, func(cond ast.Expr) bool {
+		for _, obj := range objs {
+			if comparesWithConstant(cond, obj, ctx) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// NewUncheckedShift detects left shifts by a variable count which is not checked
+// against a bound beforehand, and shifts converted to a narrower integer type.
+// The shifts by a count greater than the width of their type silently result in
+// zero, which breaks the sizes and flags computed with them.
+func NewUncheckedShift(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &uncheckedShift{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Left shift by an unchecked count or converted to a narrower type may overflow",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil), (*ast.AssignStmt)(nil), (*ast.CallExpr)(nil)}
+}
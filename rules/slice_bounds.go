@@ -0,0 +1,263 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type uncheckedSliceBound struct {
+	gosec.MetaData
+}
+
+func (r *uncheckedSliceBound) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *uncheckedSliceBound) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	slice, ok := n.(*ast.SliceExpr)
+	if !ok {
+		return nil, nil
+	}
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, slice.Pos(), slice.End())
+	for _, bound := range []ast.Expr{slice.Low, slice.High, slice.Max} {
+		if bound == nil || isConstant(bound, ctx) || isLenDerived(bound, ctx) || isReadCount(bound, slice.X, ctx) {
+			continue
+		}
+		objs := boundObjects(bound, ctx)
+		if len(objs) == 0 || isBoundChecked(path, slice.X, objs, ctx) {
+			continue
+		}
+		return gosec.NewIssue(ctx, slice, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// isLenDerived returns true if the expression calls len or cap, or is a
+// variable which was declared from such an expression.
+func isLenDerived(expr ast.Expr, ctx *gosec.Context) bool {
+	if callsLen(expr, ctx) {
+		return true
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	fn := gosec.GetEnclosingFuncDecl(expr, ctx)
+	if obj == nil || fn == nil || fn.Body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != len(assign.Rhs) {
+			return !found
+		}
+		for i, lhs := range assign.Lhs {
+			if lhsIdent, ok := lhs.(*ast.Ident); ok && ctx.Info.Defs[lhsIdent] == obj {
+				found = callsLen(assign.Rhs[i], ctx)
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// readCallNames are the functions and methods returning the number of bytes
+// they read into, or copied to, the slice they are given, e.g. n, err := r.Read(buf)
+var readCallNames = map[string]bool{"Read": true, "ReadAt": true, "ReadFull": true, "ReadAtLeast": true, "copy": true}
+
+// isReadCount returns true if the bound is a variable assigned the count of a
+// read into the sliced value, which is never larger than its length.
+func isReadCount(bound ast.Expr, data ast.Expr, ctx *gosec.Context) bool {
+	ident, ok := bound.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := ctx.Info.ObjectOf(ident)
+	fn := gosec.GetEnclosingFuncDecl(bound, ctx)
+	if obj == nil || fn == nil || fn.Body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) == 0 || len(assign.Rhs) != 1 {
+			return !found
+		}
+		if lhs, ok := assign.Lhs[0].(*ast.Ident); !ok || ctx.Info.ObjectOf(lhs) != obj {
+			return !found
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+		name := ""
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			name = fun.Name
+		case *ast.SelectorExpr:
+			name = fun.Sel.Name
+		}
+		if readCallNames[name] {
+			for _, arg := range call.Args {
+				found = found || types.ExprString(arg) == types.ExprString(data)
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func callsLen(expr ast.Expr, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			if fun, ok := call.Fun.(*ast.Ident); ok {
+				obj := ctx.Info.Uses[fun]
+				found = obj == types.Universe.Lookup("len") || obj == types.Universe.Lookup("cap")
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// boundObjects returns the variables the bound of a slice expression depends on
+func boundObjects(bound ast.Expr, ctx *gosec.Context) map[types.Object]bool {
+	objs := make(map[types.Object]bool)
+	ast.Inspect(bound, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok {
+			if v, ok := ctx.Info.ObjectOf(ident).(*types.Var); ok {
+				objs[v] = true
+			}
+		}
+		return true
+	})
+	return objs
+}
+
+// isBoundChecked returns true if the slice expression is dominated by a
+// comparison between one of the bound variables and the length of the sliced
+// value, or by a range over the sliced value.
+func isBoundChecked(path []ast.Node, data ast.Expr, objs map[types.Object]bool, ctx *gosec.Context) bool {
+	checked := isDominatedBy(path, func(cond ast.Expr) bool {
+		return comparesWithLen(cond, data, objs, ctx)
+	})
+	if checked {
+		return true
+	}
+	for i := 1; i < len(path); i++ {
+		switch node := path[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.RangeStmt:
+			if key, ok := node.Key.(*ast.Ident); ok && objs[ctx.Info.ObjectOf(key)] &&
+				types.ExprString(node.X) == types.ExprString(data) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isDominatedBy returns true if the first node of the path is dominated by a
+// condition satisfying the check. This is either the condition of an enclosing
+// if or for statement, or of an if statement preceding the node in one of the
+// enclosing blocks of the function.
+func isDominatedBy(path []ast.Node, check func(cond ast.Expr) bool) bool {
+	for i := 1; i < len(path); i++ {
+		switch node := path[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if node.Cond != nil && check(node.Cond) {
+				return true
+			}
+		case *ast.ForStmt:
+			if node.Cond != nil && check(node.Cond) {
+				return true
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range node.List {
+				if stmt == path[i-1] {
+					break
+				}
+				if ifStmt, ok := stmt.(*ast.IfStmt); ok && check(ifStmt.Cond) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// comparesWithLen returns true if the condition compares one of the variables
+// with len(data) or cap(data).
+func comparesWithLen(cond ast.Expr, data ast.Expr, objs map[types.Object]bool, ctx *gosec.Context) bool {
+	if cond == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(cond, func(node ast.Node) bool {
+		binary, ok := node.(*ast.BinaryExpr)
+		if !ok {
+			return !found
+		}
+		switch binary.Op {
+		case token.LSS, token.LEQ, token.GTR, token.GEQ, token.EQL, token.NEQ:
+			found = (usesAny(binary.X, objs, ctx) && lenOf(binary.Y, data, ctx)) ||
+				(usesAny(binary.Y, objs, ctx) && lenOf(binary.X, data, ctx))
+		}
+		return !found
+	})
+	return found
+}
+
+func usesAny(expr ast.Expr, objs map[types.Object]bool, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && objs[ctx.Info.ObjectOf(ident)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// lenOf returns true if the expression contains len(data) or cap(data)
+func lenOf(expr ast.Expr, data ast.Expr, ctx *gosec.Context) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return !found
+		}
+		if fun, ok := call.Fun.(*ast.Ident); ok {
+			obj := ctx.Info.Uses[fun]
+			if obj == types.Universe.Lookup("len") || obj == types.Universe.Lookup("cap") {
+				found = types.ExprString(call.Args[0]) == types.ExprString(data)
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// NewUncheckedSliceBound detects slice expressions whose bounds are not checked
+// against the length of the sliced value and may panic at runtime.
+func NewUncheckedSliceBound(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &uncheckedSliceBound{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Low,
+			What:       "Slice bound is not checked against the length of the slice and may panic",
+		},
+	}, []ast.Node{(*ast.SliceExpr)(nil)}
+}
@@ -16,13 +16,18 @@ package rules
 
 import (
 	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
 
 	"github.com/cosmos/gosec/v2"
 )
 
 type templateCheck struct {
 	gosec.MetaData
-	calls gosec.CallList
+	calls     gosec.CallList
+	escapes   gosec.CallList
+	textParse gosec.CallList
 }
 
 func (t *templateCheck) ID() string {
@@ -32,16 +37,53 @@ func (t *templateCheck) ID() string {
 func (t *templateCheck) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
 	if node := t.calls.ContainsPkgCallExpr(n, c, false); node != nil {
 		for _, arg := range node.Args {
-			if _, ok := arg.(*ast.BasicLit); !ok { // basic lits are safe
+			// constants and escaped values are safe
+			if !isConstant(arg, c) && t.escapes.ContainsPkgCallExpr(arg, c, false) == nil {
 				return gosec.NewIssue(c, n, t.ID(), t.What, t.Severity, t.Confidence), nil
 			}
 		}
+		return nil, nil
+	}
+	if call, ok := n.(*ast.CallExpr); ok && t.rendersHTML(call, c) {
+		what := "text/template does not escape HTML, use html/template instead"
+		return gosec.NewIssue(c, n, t.ID(), what, t.Severity, t.Confidence), nil
 	}
 	return nil, nil
 }
 
+// rendersHTML returns true if a text/template template is executed into an HTTP
+// response or parsed from HTML files.
+func (t *templateCheck) rendersHTML(call *ast.CallExpr, c *gosec.Context) bool {
+	method := ""
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if selection, ok := c.Info.Selections[sel]; ok && selection.Kind() == types.MethodVal {
+			recv := types.TypeString(selection.Recv(), nil)
+			if normalize(recv) != "text/template.Template" {
+				return false
+			}
+			method = sel.Sel.Name
+		}
+	}
+	switch {
+	case method == "Execute" || method == "ExecuteTemplate":
+		return len(call.Args) > 0 && types.TypeString(c.Info.TypeOf(call.Args[0]), nil) == "net/http.ResponseWriter"
+	case method == "ParseFiles" || method == "ParseGlob" || t.textParse.ContainsPkgCallExpr(call, c, false) != nil:
+		for _, arg := range call.Args {
+			if tv, ok := c.Info.Types[arg]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+				name := strings.ToLower(constant.StringVal(tv.Value))
+				if strings.HasSuffix(name, ".html") || strings.HasSuffix(name, ".htm") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // NewTemplateCheck constructs the template check rule. This rule is used to
-// find use of templates where HTML/JS escaping is not being used
+// find use of templates where HTML/JS escaping is not being used, either because
+// non-constant data is converted to the trusted template types or because HTML
+// is rendered with text/template
 func NewTemplateCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 
 	calls := gosec.NewCallList()
@@ -49,8 +91,16 @@ func NewTemplateCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	calls.Add("html/template", "HTMLAttr")
 	calls.Add("html/template", "JS")
 	calls.Add("html/template", "URL")
+	escapes := gosec.NewCallList()
+	escapes.AddAll("html/template", "HTMLEscapeString", "JSEscapeString", "URLQueryEscaper")
+	escapes.Add("html", "EscapeString")
+	escapes.AddAll("net/url", "QueryEscape", "PathEscape")
+	textParse := gosec.NewCallList()
+	textParse.AddAll("text/template", "ParseFiles", "ParseGlob")
 	return &templateCheck{
-		calls: calls,
+		calls:     calls,
+		escapes:   escapes,
+		textParse: textParse,
 		MetaData: gosec.MetaData{
 			ID:         id,
 			Severity:   gosec.Medium,
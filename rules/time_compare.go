@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type timeComparison struct {
+	gosec.MetaData
+}
+
+func (r *timeComparison) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *timeComparison) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	binary, ok := n.(*ast.BinaryExpr)
+	if !ok || (binary.Op != token.EQL && binary.Op != token.NEQ) {
+		return nil, nil
+	}
+	if !isTime(ctx.Info.TypeOf(binary.X)) || !isTime(ctx.Info.TypeOf(binary.Y)) {
+		return nil, nil
+	}
+	// comparing with the zero value, time.Time{}, is left to IsZero
+	for _, operand := range []ast.Expr{binary.X, binary.Y} {
+		if lit, ok := operand.(*ast.CompositeLit); ok && len(lit.Elts) == 0 {
+			return nil, nil
+		}
+	}
+	return gosec.NewIssue(ctx, binary, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isTime returns true if the type is time.Time
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// NewTimeComparison detects time.Time values compared with == or !=, which
+// compares their location and monotonic clock reading along with the instant
+// they represent. Two times representing the same instant can differ, and
+// Equal should be used instead.
+func NewTimeComparison(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &timeComparison{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+			What:       "time.Time compared with == or !=, use Equal to compare the instants",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil)}
+}
@@ -0,0 +1,201 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type missingUnlock struct {
+	gosec.MetaData
+}
+
+func (r *missingUnlock) ID() string {
+	return r.MetaData.ID
+}
+
+var unlockOf = map[string]string{"Lock": "Unlock", "RLock": "RUnlock"}
+
+// lockHelperName matches the snake case names of the helpers returning with the
+// mutex held on purpose, e.g. lockState, and not e.g. EndBlock or ProcessBlock
+var lockHelperName = regexp.MustCompile(`(^|_)(r?lock|r?locked|unlock)(_|$)`)
+
+func (r *missingUnlock) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil
+	}
+	mutex, method := syncCall(stmt, ctx)
+	if mutex == nil || unlockOf[method] == "" {
+		return nil, nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(ctx.Root, stmt.Pos(), stmt.End())
+	for len(path) > 0 && path[0] != stmt {
+		path = path[1:]
+	}
+	if len(path) < 2 {
+		return nil, nil
+	}
+	block, ok := path[1].(*ast.BlockStmt)
+	if !ok {
+		return nil, nil
+	}
+	var body *ast.BlockStmt
+	for _, node := range path[2:] {
+		if fn, ok := node.(*ast.FuncDecl); ok {
+			// helpers such as lockState return with the mutex held on purpose
+			if lockHelperName.MatchString(gosec.SnakeCase(fn.Name.Name)) {
+				return nil, nil
+			}
+			body = fn.Body
+			break
+		}
+		if fn, ok := node.(*ast.FuncLit); ok {
+			body = fn.Body
+			break
+		}
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	isUnlock := func(s ast.Stmt) bool {
+		var call ast.Stmt = s
+		if deferStmt, ok := s.(*ast.DeferStmt); ok {
+			call = &ast.ExprStmt{X: deferStmt.Call}
+		}
+		other, name := syncCall(call, ctx)
+		return other != nil && name == unlockOf[method] && sameMutex(mutex, other, ctx)
+	}
+	var rest []ast.Stmt
+	for i, s := range block.List {
+		if s == stmt {
+			rest = block.List[i+1:]
+		}
+	}
+	if ret := returnsLocked(rest, isUnlock); ret != nil {
+		return gosec.NewIssue(ctx, ret, r.ID(), "Function returns with the mutex locked, unlock it with defer", r.Severity, r.Confidence), nil
+	}
+	if block != body {
+		return nil, nil
+	}
+	for _, s := range rest {
+		if isUnlock(s) {
+			return nil, nil
+		}
+	}
+	return gosec.NewIssue(ctx, stmt, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// syncCall returns the receiver and the name of a method of the sync package
+// called by the statement.
+func syncCall(stmt ast.Stmt, ctx *gosec.Context) (ast.Expr, string) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, ""
+	}
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, ""
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, ""
+	}
+	selection, ok := ctx.Info.Selections[sel]
+	if !ok || selection.Obj().Pkg() == nil || selection.Obj().Pkg().Path() != "sync" {
+		return nil, ""
+	}
+	return sel.X, sel.Sel.Name
+}
+
+// sameMutex returns true if both expressions refer to the same mutex variable
+func sameMutex(a, b ast.Expr, ctx *gosec.Context) bool {
+	return types.ExprString(a) == types.ExprString(b) && mutexObject(a, ctx) == mutexObject(b, ctx)
+}
+
+func mutexObject(expr ast.Expr, ctx *gosec.Context) types.Object {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return ctx.Info.ObjectOf(e)
+	case *ast.SelectorExpr:
+		return ctx.Info.ObjectOf(e.Sel)
+	case *ast.ParenExpr:
+		return mutexObject(e.X, ctx)
+	case *ast.StarExpr:
+		return mutexObject(e.X, ctx)
+	case *ast.UnaryExpr:
+		return mutexObject(e.X, ctx)
+	}
+	return nil
+}
+
+// returnsLocked returns the first return statement reached before the mutex is
+// unlocked. An unlock only covers the statements following it in its block.
+func returnsLocked(stmts []ast.Stmt, isUnlock func(ast.Stmt) bool) ast.Node {
+	for _, stmt := range stmts {
+		if isUnlock(stmt) {
+			return nil
+		}
+		if ret, ok := stmt.(*ast.ReturnStmt); ok {
+			return ret
+		}
+		for _, nested := range nestedStmts(stmt) {
+			if ret := returnsLocked(nested, isUnlock); ret != nil {
+				return ret
+			}
+		}
+	}
+	return nil
+}
+
+// nestedStmts returns the statement lists nested in a statement, leaving out
+// function literals which return on their own.
+func nestedStmts(stmt ast.Stmt) [][]ast.Stmt {
+	switch s := stmt.(type) {
+	case *ast.BlockStmt:
+		return [][]ast.Stmt{s.List}
+	case *ast.LabeledStmt:
+		return [][]ast.Stmt{{s.Stmt}}
+	case *ast.IfStmt:
+		lists := [][]ast.Stmt{s.Body.List}
+		if s.Else != nil {
+			lists = append(lists, []ast.Stmt{s.Else})
+		}
+		return lists
+	case *ast.ForStmt:
+		return [][]ast.Stmt{s.Body.List}
+	case *ast.RangeStmt:
+		return [][]ast.Stmt{s.Body.List}
+	case *ast.SwitchStmt:
+		return nestedStmts(s.Body)
+	case *ast.TypeSwitchStmt:
+		return nestedStmts(s.Body)
+	case *ast.SelectStmt:
+		return nestedStmts(s.Body)
+	case *ast.CaseClause:
+		return [][]ast.Stmt{s.Body}
+	case *ast.CommClause:
+		return [][]ast.Stmt{s.Body}
+	}
+	return nil
+}
+
+// NewMissingUnlock detects mutexes locked by a function which are not unlocked
+// on every path, either because the function never unlocks them or because it
+// returns early. Unlocking with defer right after locking avoids both.
+func NewMissingUnlock(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &missingUnlock{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.Medium,
+			Confidence: gosec.Medium,
+			What:       "Mutex locked without being unlocked, unlock it with defer",
+		},
+	}, []ast.Node{(*ast.ExprStmt)(nil)}
+}
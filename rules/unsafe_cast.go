@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/cosmos/gosec/v2"
+)
+
+type unsafeCast struct {
+	gosec.MetaData
+}
+
+func (r *unsafeCast) ID() string {
+	return r.MetaData.ID
+}
+
+// Match flags the conversions of an unsafe.Pointer to a pointer type, which is
+// how the memory of a value is reinterpreted as another type, e.g.
+// *(*T)(unsafe.Pointer(&x)). The target type can be a type parameter.
+func (r *unsafeCast) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isConversion(call, ctx) {
+		return nil, nil
+	}
+	target, ok := ctx.Info.TypeOf(call).Underlying().(*types.Pointer)
+	if !ok {
+		return nil, nil
+	}
+	arg := call.Args[0]
+	for {
+		paren, ok := arg.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		arg = paren.X
+	}
+	if !isUnsafePointer(ctx.Info.TypeOf(arg)) {
+		return nil, nil
+	}
+	// converting back to the type the pointer was made from is not a reinterpretation
+	if inner, ok := arg.(*ast.CallExpr); ok && len(inner.Args) == 1 && isConversion(inner, ctx) {
+		if source, ok := ctx.Info.TypeOf(inner.Args[0]).(*types.Pointer); ok && types.Identical(source.Elem(), target.Elem()) {
+			return nil, nil
+		}
+	}
+	return gosec.NewIssue(ctx, call, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// isConversion returns true if the call is a type conversion
+func isConversion(call *ast.CallExpr, ctx *gosec.Context) bool {
+	tv, ok := ctx.Info.Types[call.Fun]
+	return ok && tv.IsType()
+}
+
+// isUnsafePointer returns true if the type is unsafe.Pointer
+func isUnsafePointer(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.UnsafePointer
+}
+
+// NewUnsafeCast detects the conversions of an unsafe.Pointer to another pointer
+// type, e.g. *(*T)(unsafe.Pointer(&x)), including when the target type is a type
+// parameter of a generic function. The memory is reinterpreted as the new type
+// regardless of its size, alignment and layout. The encoding/binary package or
+// explicit conversions should be used instead.
+func NewUnsafeCast(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unsafeCast{
+		MetaData: gosec.MetaData{
+			ID:         id,
+			Severity:   gosec.High,
+			Confidence: gosec.Medium,
+			What:       "Memory reinterpreted as another type through an unsafe.Pointer conversion",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}